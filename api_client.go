@@ -2,24 +2,56 @@ package paystack
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"net/url"
 	"strings"
+	"sync"
+	"time"
 )
 
 const Version = "0.1.0"
 const BaseUrl = "https://api.paystack.co"
 
+// userAgent is the User-Agent header sent with every request
+const userAgent = "github.com/gray-adeyi/paystack version " + Version
+
 var ErrNoSecretKey = errors.New("Paystack secret key was not provided")
 
-// Response is a struct containing the status code and data retrieved from paystack. Response.Data is a slice of
-// byte that is JSON serializable.
+// Response is a struct containing the status code, data and headers retrieved from paystack. Response.Data is a
+// slice of byte that is JSON serializable.
 type Response struct {
 	StatusCode int
 	Data       []byte
+	// Headers are the headers of the response
+	Headers http.Header
+	// RequestId is the id paystack assigned to the request, useful for correlating logs with paystack's
+	// support. It is empty if paystack did not return one.
+	RequestId string
+	// RateLimit is the rate limit information paystack returned with the response
+	RateLimit RateLimitInfo
+
+	// endpoint is the method and path of the request the Response is for
+	endpoint string
+}
+
+// envelope is the structure shared by the responses of paystack's endpoints
+type envelope struct {
+	Status  bool            `json:"status"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// envelope deserializes Response.Data into the structure shared by the responses of paystack's endpoints
+func (r *Response) envelope() (envelope, error) {
+	var e envelope
+	err := r.Decode(&e)
+	return e, err
 }
 
 // ClientOptions is a type used to modify attributes of an APIClient. It can be passed into the NewAPIClient
@@ -47,6 +79,88 @@ func WithBaseUrl(baseUrl string) ClientOptions {
 	}
 }
 
+// WithBasePath lets you set a path prefix that is added to the path of every endpoint of an APIClient.
+// It is useful when paystack is fronted with an API gateway that routes requests by a path prefix.
+// The base url, base path and endpoint path are joined without duplicate or missing slashes.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"),
+//		p.WithBaseUrl("https://gateway.internal"), p.WithBasePath("/vendor/paystack"))
+//	// client.Transactions.Verify("<reference>") calls https://gateway.internal/vendor/paystack/transaction/verify/<reference>
+func WithBasePath(basePath string) ClientOptions {
+	return func(client *APIClient) {
+		client.basePath = basePath
+	}
+}
+
+// WithSecondarySecretKey lets you enable the dual-key mode of an APIClient. In dual-key mode, a request
+// that fails with a 401 status code is retried once with the secondary key. This is useful during a key
+// rotation window where either of the keys might be the one paystack accepts.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//	client := p.NewAPIClient(p.WithSecretKey("<new-paystack-secret-key>"),
+//		p.WithSecondarySecretKey("<old-paystack-secret-key>"))
+func WithSecondarySecretKey(secretKey string) ClientOptions {
+	return func(client *APIClient) {
+		client.secondarySecretKey = secretKey
+	}
+}
+
+// WithInsecureSkipVerify lets you disable TLS certificate verification for an APIClient. It is intended
+// for testing against local mock servers with self-signed certificates and it only takes effect when the
+// base url of the APIClient has been overridden with WithBaseUrl. It refuses to activate when the base
+// url is paystack's production host, so that production traffic is never sent insecurely, or when the
+// transport of the client is not an *http.Transport, whose verification it can not disable. Every call of
+// the client then fails with an error wrapping ErrInsecureSkipVerifyRefused, so that the option is never
+// silently ignored.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"),
+//		p.WithBaseUrl("https://localhost:8443"), p.WithInsecureSkipVerify())
+func WithInsecureSkipVerify() ClientOptions {
+	return func(client *APIClient) {
+		client.insecureSkipVerify = true
+	}
+}
+
+// RequestSigner is implemented by types that sign requests before they are sent to paystack. It is
+// useful when routing traffic through an egress proxy that requires requests to carry extra metadata,
+// e.g. an HMAC of the request in a header. Sign is called after the headers of the request have been
+// set, and the body of the request can be read without consuming it via request.GetBody.
+type RequestSigner interface {
+	Sign(request *http.Request) error
+}
+
+// RequestSignerFunc is an adapter that allows the use of an ordinary function as a RequestSigner
+type RequestSignerFunc func(request *http.Request) error
+
+// Sign calls f(request)
+func (f RequestSignerFunc) Sign(request *http.Request) error {
+	return f(request)
+}
+
+// WithRequestSigner lets you set a RequestSigner that signs every request of an APIClient before it is sent.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"),
+//		p.WithRequestSigner(p.RequestSignerFunc(func(request *http.Request) error {
+//			request.Header.Set("X-Egress-Signature", sign(request))
+//			return nil
+//		})))
+func WithRequestSigner(signer RequestSigner) ClientOptions {
+	return func(client *APIClient) {
+		client.requestSigner = signer
+	}
+}
+
 // OptionalPayloadParameter is a type for storing optional parameters used by some APIClient methods that needs
 // to accept optional parameter.
 type OptionalPayloadParameter = func(map[string]interface{}) map[string]interface{}
@@ -70,62 +184,312 @@ func WithOptionalParameter(key string, value interface{}) OptionalPayloadParamet
 }
 
 type baseAPIClient struct {
-	secretKey  string
-	baseUrl    string
-	httpClient *http.Client
+	mu                 sync.RWMutex
+	secretKey          string
+	secondarySecretKey string
+	baseUrl            string
+	basePath           string
+	httpClient         *http.Client
+	transport          http.RoundTripper
+	insecureSkipVerify bool
+	requestSigner      RequestSigner
+	statusPageUrl      string
+	dryRun             bool
+	gzipMinSize        int
+	stats              *statsCollector
+	dnsCacheTTL        time.Duration
+	endpointTimeouts   map[EndpointClass]time.Duration
+	defaultTimeout     time.Duration
+	maxRetries         int
+	retryBudget        *retryBudget
+	verifyCache        VerifyCache
+	verifyCacheTTL     time.Duration
+	maxResponseBytes   int64
+	rateLimitBehavior  RateLimitBehavior
+	// autoIdempotencyKeys is true if an idempotency key should be generated for mutating calls
+	autoIdempotencyKeys bool
+	middlewares         []Middleware
+	coalescer           *requestCoalescer
+	metrics             MetricsCollector
+	logger              *slog.Logger
+	circuitBreaker      *circuitBreaker
+	preflight           *PreflightResult
+	strictErrors        bool
+	panicPolicy         PanicPolicy
+	versionTelemetry    bool
+	secretKeyProvider   SecretKeyProvider
+	// transportErr is returned by every call if the transport could not be built as configured
+	transportErr error
+	// notesLock serialises CustomerClient.AppendNote so that its calls do not overwrite each other's notes
+	notesLock chan struct{}
 }
 
 func (a *baseAPIClient) APICall(method string, endPointPath string, payload interface{}) (*Response, error) {
-	var body *bytes.Buffer
-	var apiRequest *http.Request
-	var err error
+	return a.apiCall(context.Background(), method, endPointPath, payload)
+}
+
+// apiCall is APICall with the context of the call. The call is aborted with an error wrapping
+// ErrRequestCanceled when ctx is done.
+func (a *baseAPIClient) apiCall(ctx context.Context, method string, endPointPath string, payload interface{}) (*Response, error) {
+	var body []byte
 
+	if a.transportErr != nil {
+		return nil, a.transportErr
+	}
+	if options := callOptionsFrom(ctx); options.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.timeout)
+		defer cancel()
+	}
+	idempotencyKey := a.idempotencyKey(ctx, method)
 	if payload != nil {
-		payloadInBytes, err := json.Marshal(payload)
+		payloadInBytes, err := encodePayload(payload)
 		if err != nil {
 			return nil, err
 		}
-		body = bytes.NewBuffer(payloadInBytes)
+		body = payloadInBytes
 	}
 
-	if body != nil {
-		apiRequest, err = http.NewRequest(method, a.baseUrl+endPointPath, body)
+	if a.dryRun && method != http.MethodGet {
+		return a.dryRunResponse(ctx, method, endPointPath, body)
+	}
+
+	body, contentEncoding, err := a.compressPayload(endPointPath, body)
+	if err != nil {
+		return nil, err
+	}
+	headers := make(http.Header)
+	if contentEncoding != "" {
+		headers.Set("Content-Encoding", contentEncoding)
+	}
+	if idempotencyKey != "" {
+		headers.Set(IdempotencyKeyHeader, idempotencyKey)
+	}
+
+	var response *Response
+	if a.coalescer != nil && method == http.MethodGet {
+		response, err = a.coalescer.do(ctx, endPointPath, func() (*Response, error) {
+			return a.send(ctx, method, endPointPath, body, headers)
+		})
 	} else {
-		apiRequest, err = http.NewRequest(method, a.baseUrl+endPointPath, nil)
+		response, err = a.send(ctx, method, endPointPath, body, headers)
+	}
+	if err != nil || !a.strictErrors {
+		return response, err
 	}
+	if apiErr := response.AsError(); apiErr != nil {
+		return nil, apiErr
+	}
+	return response, nil
+}
 
+// send sends a request, handling retries, rate limits and key rotation
+func (a *baseAPIClient) send(ctx context.Context, method string, endPointPath string, body []byte, headers http.Header) (*Response, error) {
+	secretKey, secondarySecretKey, err := a.secretKeys(ctx)
 	if err != nil {
 		return nil, err
 	}
-	err = a.setHeaders(apiRequest)
+	response, err := a.doRequestWithRetries(ctx, method, endPointPath, body, headers, secretKey)
 	if err != nil {
 		return nil, err
 	}
-	r, err := a.httpClient.Do(apiRequest)
+	response, err = a.handleRateLimit(ctx, response, func() (*Response, error) {
+		return a.doRequest(ctx, method, endPointPath, body, headers, secretKey)
+	})
 	if err != nil {
 		return nil, err
 	}
+	// during a key rotation window, the request is retried once with the secondary key
+	if response.StatusCode == http.StatusUnauthorized && secondarySecretKey != "" && secondarySecretKey != secretKey {
+		return a.doRequest(ctx, method, endPointPath, body, headers, secondarySecretKey)
+	}
+	return response, nil
+}
 
-	data, err := io.ReadAll(r.Body)
+func (a *baseAPIClient) doRequest(ctx context.Context, method string, endPointPath string, body []byte, headers http.Header, secretKey string) (*Response, error) {
+	var apiRequest *http.Request
+	var err error
+
+	// the timeout of the endpoint class only applies when the caller has not set a deadline
+	requestCtx := ctx
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline && a.endpointTimeout(endPointPath) > 0 {
+		var cancel context.CancelFunc
+		requestCtx, cancel = context.WithTimeout(ctx, a.endpointTimeout(endPointPath))
+		defer cancel()
+	}
+	if body != nil {
+		apiRequest, err = http.NewRequestWithContext(requestCtx, method, a.endpointUrl(endPointPath), bytes.NewReader(body))
+	} else {
+		apiRequest, err = http.NewRequestWithContext(requestCtx, method, a.endpointUrl(endPointPath), nil)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	err = a.setHeaders(apiRequest, secretKey)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range headers {
+		apiRequest.Header[key] = values
+	}
+	if a.requestSigner != nil {
+		if err = a.requestSigner.Sign(apiRequest); err != nil {
+			return nil, err
+		}
+	}
+	// the circuit breaker is consulted last, so that a request that fails before being sent never holds its probe
+	ticket, err := a.circuitBreaker.allow(method+" "+endPointPath, time.Now())
 	if err != nil {
 		return nil, err
 	}
-	return &Response{
+	a.logRequest(ctx, apiRequest, body)
+	start := time.Now()
+	// fail records a request that did not receive a complete response
+	fail := func(err error) (*Response, error) {
+		a.recordRequest(ctx, method, endPointPath, time.Since(start), 0, err)
+		return nil, err
+	}
+	r, err := a.httpClient.Do(apiRequest)
+	if err != nil {
+		if ctx.Err() != nil {
+			a.circuitBreaker.release(ticket)
+		} else {
+			a.circuitBreaker.record(ticket, true, time.Now())
+		}
+		return fail(requestError(ctx, method+" "+endPointPath, err))
+	}
+	a.circuitBreaker.record(ticket, r.StatusCode >= http.StatusInternalServerError, time.Now())
+	defer r.Body.Close()
+
+	responseBody, err := a.limitBody(r, method+" "+endPointPath)
+	if err != nil {
+		return fail(err)
+	}
+	data, err := readBody(responseBody, r.ContentLength)
+	if err != nil {
+		return fail(requestError(ctx, method+" "+endPointPath, err))
+	}
+	if a.maxResponseBytes > 0 && int64(len(data)) > a.maxResponseBytes {
+		return fail(a.responseTooLarge(r, method+" "+endPointPath))
+	}
+	a.recordRequest(ctx, method, endPointPath, time.Since(start), r.StatusCode, nil)
+	response := &Response{
 		StatusCode: r.StatusCode,
 		Data:       data,
-	}, nil
+		Headers:    r.Header,
+		RequestId:  requestId(r.Header),
+		RateLimit:  parseRateLimit(r.Header, time.Now()),
+		endpoint:   method + " " + endPointPath,
+	}
+	return response, nil
+}
+
+// bufferPool holds the buffers used to encode payloads and read response bodies, so that the buffers
+// are not reallocated and grown on every request.
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
 }
 
-func (a *baseAPIClient) setHeaders(request *http.Request) error {
-	if a.secretKey == "" {
+// encodePayload serializes payload into a pooled buffer and returns a copy of exactly its size
+func encodePayload(payload interface{}) ([]byte, error) {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	defer func() {
+		buf.Reset()
+		bufferPool.Put(buf)
+	}()
+	if err := json.NewEncoder(buf).Encode(payload); err != nil {
+		return nil, err
+	}
+	// Encode terminates the value with a newline which json.Marshal does not
+	return append([]byte(nil), bytes.TrimSuffix(buf.Bytes(), []byte("\n"))...), nil
+}
+
+// readBody reads body, whose size is contentLength if known, into a pooled buffer and returns a copy of
+// exactly its size
+func readBody(body io.Reader, contentLength int64) ([]byte, error) {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	defer func() {
+		buf.Reset()
+		bufferPool.Put(buf)
+	}()
+	if contentLength > 0 {
+		buf.Grow(int(contentLength))
+	}
+	if _, err := buf.ReadFrom(body); err != nil {
+		return nil, err
+	}
+	return append([]byte(nil), buf.Bytes()...), nil
+}
+
+// endpointUrl joins the base url, base path and endPointPath into the url of an endpoint
+func (a *baseAPIClient) endpointUrl(endPointPath string) string {
+	endpointUrl := strings.TrimRight(a.baseUrl, "/")
+	if basePath := strings.Trim(a.basePath, "/"); basePath != "" {
+		endpointUrl += "/" + basePath
+	}
+	return endpointUrl + "/" + strings.TrimLeft(endPointPath, "/")
+}
+
+func (a *baseAPIClient) setHeaders(request *http.Request, secretKey string) error {
+	if secretKey == "" {
 		return ErrNoSecretKey
 	}
-	request.Header.Set("Authorization", fmt.Sprintf("Bearer %s", a.secretKey))
-	request.Header.Set("User-Agent", fmt.Sprintf("github.com/gray-adeyi/paystack version %s", Version))
+	request.Header.Set("Authorization", "Bearer "+secretKey)
+	request.Header.Set("User-Agent", a.userAgentHeader())
 	request.Header.Add("Content-Type", "application/json")
 	return nil
 }
 
+// lookupId retrieves the numeric id of the resource at endPointPath. An empty id is returned alongside the
+// response if the resource could not be retrieved.
+func (a *baseAPIClient) lookupId(ctx context.Context, endPointPath string) (string, *Response, error) {
+	resp, err := a.apiCall(ctx, http.MethodGet, endPointPath, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	var resource struct {
+		Status bool `json:"status"`
+		Data   struct {
+			Id json.Number `json:"id"`
+		} `json:"data"`
+	}
+	if err = resp.Decode(&resource); err != nil || !resource.Status {
+		return "", resp, nil
+	}
+	return resource.Data.Id.String(), resp, nil
+}
+
+// RotateSecretKey lets you atomically swap the secret key used by the client. Calls made after
+// RotateSecretKey returns use the new key, calls already in flight are unaffected. Since all the dedicated
+// clients of an APIClient share the same underlying client, rotating the key on the APIClient
+// rotates it for all of them. The key has no effect if the client has a SecretKeyProvider.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<old-paystack-secret-key>"))
+//	// keep the old key as a fallback until the rotation is complete on paystack's end
+//	client.SetSecondarySecretKey("<old-paystack-secret-key>")
+//	client.RotateSecretKey("<new-paystack-secret-key>")
+func (a *baseAPIClient) RotateSecretKey(newSecretKey string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.secretKey = newSecretKey
+}
+
+// SetSecondarySecretKey lets you set the secondary secret key of the client at runtime. When a secondary
+// secret key is set, a request that fails with a 401 status code is retried once with the secondary key.
+// Passing an empty string disables the dual-key mode.
+func (a *baseAPIClient) SetSecondarySecretKey(secretKey string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.secondarySecretKey = secretKey
+}
+
 // APIClient is a struct that has other dedicated clients bound to it. This provides a convenience for interacting
 // with all of paystack's endpoints in your Go project. It should not be instantiated directly but interacting but
 // via the NewAPIClient function. As stated above, it has other dedicated clients bound to it as field, therefore,
@@ -138,7 +502,7 @@ func (a *baseAPIClient) setHeaders(request *http.Request) error {
 //	client := p.NewAPIClient(p.WithSecretKey("<your-paystack-secret-key>"))
 //	resp, err := client.Transactions.Verify("<reference>")
 type APIClient struct {
-	baseAPIClient
+	*baseAPIClient
 
 	// Transactions let you interact with endpoints related to paystack Transaction resource
 	// that allows you to create and manage payments on your Integration.
@@ -242,8 +606,15 @@ type APIClient struct {
 //
 //	client := p.NewAPIClient(p.WithSecretKey("<your-paystack-secret-key>"))
 func NewAPIClient(options ...ClientOptions) *APIClient {
-	baseClient := &baseAPIClient{}
+	baseClient := &baseAPIClient{
+		baseUrl:       BaseUrl,
+		httpClient:    &http.Client{},
+		statusPageUrl: StatusPageUrl,
+		stats:         newStatsCollector(),
+		notesLock:     make(chan struct{}, 1),
+	}
 	newClient := &APIClient{
+		baseAPIClient: baseClient,
 		Transactions: &TransactionClient{
 			baseClient,
 		},
@@ -314,113 +685,25 @@ func NewAPIClient(options ...ClientOptions) *APIClient {
 			baseClient,
 		},
 	}
-	newClient.baseUrl = BaseUrl
-
-	httpClient := &http.Client{}
-	newClient.httpClient = httpClient
 	for _, opts := range options {
 		opts(newClient)
 	}
 
-	newClient.Transactions.baseUrl = BaseUrl
-	newClient.Transactions.secretKey = newClient.secretKey
-	newClient.Transactions.httpClient = httpClient
-
-	newClient.TransactionSplits.baseUrl = BaseUrl
-	newClient.TransactionSplits.secretKey = newClient.secretKey
-	newClient.TransactionSplits.httpClient = httpClient
-
-	newClient.Terminals.baseUrl = BaseUrl
-	newClient.Terminals.secretKey = newClient.secretKey
-	newClient.Terminals.httpClient = httpClient
-
-	newClient.Customers.baseUrl = BaseUrl
-	newClient.Customers.secretKey = newClient.secretKey
-	newClient.Customers.httpClient = httpClient
-
-	newClient.DedicatedVirtualAccounts.baseUrl = BaseUrl
-	newClient.DedicatedVirtualAccounts.secretKey = newClient.secretKey
-	newClient.DedicatedVirtualAccounts.httpClient = httpClient
-
-	newClient.ApplePay.baseUrl = BaseUrl
-	newClient.ApplePay.secretKey = newClient.secretKey
-	newClient.ApplePay.httpClient = httpClient
-
-	newClient.SubAccounts.baseUrl = BaseUrl
-	newClient.SubAccounts.secretKey = newClient.secretKey
-	newClient.SubAccounts.httpClient = httpClient
-
-	newClient.Plans.baseUrl = BaseUrl
-	newClient.Plans.secretKey = newClient.secretKey
-	newClient.Plans.httpClient = httpClient
-
-	newClient.Subscriptions.baseUrl = BaseUrl
-	newClient.Subscriptions.secretKey = newClient.secretKey
-	newClient.Subscriptions.httpClient = httpClient
-
-	newClient.Products.baseUrl = BaseUrl
-	newClient.Products.secretKey = newClient.secretKey
-	newClient.Products.httpClient = httpClient
-
-	newClient.PaymentPages.baseUrl = BaseUrl
-	newClient.PaymentPages.secretKey = newClient.secretKey
-	newClient.PaymentPages.httpClient = httpClient
-
-	newClient.PaymentRequests.baseUrl = BaseUrl
-	newClient.PaymentRequests.secretKey = newClient.secretKey
-	newClient.PaymentRequests.httpClient = httpClient
-
-	newClient.Settlements.baseUrl = BaseUrl
-	newClient.Settlements.secretKey = newClient.secretKey
-	newClient.Settlements.httpClient = httpClient
-
-	newClient.TransferRecipients.baseUrl = BaseUrl
-	newClient.TransferRecipients.secretKey = newClient.secretKey
-	newClient.TransferRecipients.httpClient = httpClient
-
-	newClient.Transfers.baseUrl = BaseUrl
-	newClient.Transfers.secretKey = newClient.secretKey
-	newClient.Transfers.httpClient = httpClient
-
-	newClient.TransferControl.baseUrl = BaseUrl
-	newClient.TransferControl.secretKey = newClient.secretKey
-	newClient.TransferControl.httpClient = httpClient
-
-	newClient.BulkCharges.baseUrl = BaseUrl
-	newClient.BulkCharges.secretKey = newClient.secretKey
-	newClient.BulkCharges.httpClient = httpClient
-
-	newClient.Integration.baseUrl = BaseUrl
-	newClient.Integration.secretKey = newClient.secretKey
-	newClient.Integration.httpClient = httpClient
-
-	newClient.Charges.baseUrl = BaseUrl
-	newClient.Charges.secretKey = newClient.secretKey
-	newClient.Charges.httpClient = httpClient
-
-	newClient.Charges.baseUrl = BaseUrl
-	newClient.Charges.secretKey = newClient.secretKey
-	newClient.Charges.httpClient = httpClient
-
-	newClient.Disputes.baseUrl = BaseUrl
-	newClient.Disputes.secretKey = newClient.secretKey
-	newClient.Disputes.httpClient = httpClient
-
-	newClient.Refunds.baseUrl = BaseUrl
-	newClient.Refunds.secretKey = newClient.secretKey
-	newClient.Refunds.httpClient = httpClient
-
-	newClient.Verification.baseUrl = BaseUrl
-	newClient.Verification.secretKey = newClient.secretKey
-	newClient.Verification.httpClient = httpClient
-
-	newClient.Miscellaneous.baseUrl = BaseUrl
-	newClient.Miscellaneous.secretKey = newClient.secretKey
-	newClient.Miscellaneous.httpClient = httpClient
-
+	newClient.httpClient.Transport, newClient.transportErr = newClient.buildTransport()
 	return newClient
 }
 
+// isProductionBaseUrl checks if baseUrl points to paystack's production host
+func isProductionBaseUrl(baseUrl string) bool {
+	parsedUrl, err := url.Parse(baseUrl)
+	if err != nil {
+		// an unparsable base url is treated as production to err on the side of caution
+		return true
+	}
+	productionUrl, _ := url.Parse(BaseUrl)
+	return strings.EqualFold(parsedUrl.Hostname(), productionUrl.Hostname())
+}
+
 // Query helps represent key value pairs used in url query parametes
 type Query struct {
 	Key   string
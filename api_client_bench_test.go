@@ -0,0 +1,92 @@
+package paystack
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// The benchmarks measure the allocations of the client on the paths of Verify and Initialize, which are
+// the calls made the most by checkouts. Payloads are encoded, and response bodies read, with pooled buffers
+// but they are not encoded from pre-encoded templates, since optional parameters can add any field to a
+// payload. Most of the remaining allocations are made by net/http to build and send a request, and by the
+// context of the timeout of the request.
+
+// stubTransport responds to every request with body without making a network call, so that the
+// benchmarks only measure the allocations of the client
+type stubTransport struct {
+	body string
+}
+
+func (s stubTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Body != nil {
+		io.Copy(io.Discard, r.Body)
+		r.Body.Close()
+	}
+	return &http.Response{
+		StatusCode:    http.StatusOK,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(strings.NewReader(s.body)),
+		ContentLength: int64(len(s.body)),
+		Request:       r,
+	}, nil
+}
+
+func newBenchmarkTransactionClient(body string) *TransactionClient {
+	client := NewTransactionClient(WithSecretKey("sk_test"))
+	client.httpClient.Transport = stubTransport{body: body}
+	return client
+}
+
+func BenchmarkVerify(b *testing.B) {
+	client := newBenchmarkTransactionClient(`{"status":true,"message":"Verification successful",` +
+		`"data":{"id":4099260516,"status":"success","reference":"ref_5f1c0b9a2d3e4f5a6b7c8d9e","amount":40333,"currency":"NGN"}}`)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.Verify("ref_5f1c0b9a2d3e4f5a6b7c8d9e"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkVerifyDecode measures Verify followed by decoding the response into a typed value, which is how
+// the outcome of a payment is usually read
+func BenchmarkVerifyDecode(b *testing.B) {
+	client := newBenchmarkTransactionClient(`{"status":true,"message":"Verification successful",` +
+		`"data":{"id":4099260516,"status":"success","reference":"ref_5f1c0b9a2d3e4f5a6b7c8d9e","amount":40333,"currency":"NGN"}}`)
+	var transaction struct {
+		Data struct {
+			Id        int64  `json:"id"`
+			Status    string `json:"status"`
+			Reference string `json:"reference"`
+			Amount    int64  `json:"amount"`
+			Currency  string `json:"currency"`
+		} `json:"data"`
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		resp, err := client.Verify("ref_5f1c0b9a2d3e4f5a6b7c8d9e")
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err = resp.Decode(&transaction); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkInitialize(b *testing.B) {
+	client := newBenchmarkTransactionClient(`{"status":true,"message":"Authorization URL created",` +
+		`"data":{"authorization_url":"https://checkout.paystack.com/3ni8kdavz62431k","access_code":"3ni8kdavz62431k",` +
+		`"reference":"re4lyvq3s3"}}`)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.Initialize(200000, "johndoe@example.com", WithOptionalParameter("currency", "NGN")); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -1,8 +1,16 @@
 package paystack
 
 import (
+	"bytes"
+	"compress/gzip"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -20,3 +28,186 @@ func TestAPIClient(t *testing.T) {
 	}
 	fmt.Println(g)
 }
+
+// newTestServer creates a server that only accepts requests authorized with one of the validKeys
+func newTestServer(t *testing.T, validKeys ...string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		for _, validKey := range validKeys {
+			if key == validKey {
+				fmt.Fprintf(w, `{"status":true,"message":"ok","data":{"key":%q}}`, key)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"status":false,"message":"Invalid key"}`)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRotateSecretKey(t *testing.T) {
+	server := newTestServer(t, "sk_new")
+	client := NewAPIClient(WithSecretKey("sk_old"), WithBaseUrl(server.URL))
+
+	r, err := client.Transactions.Verify("ref")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected status code %d, got %d", http.StatusUnauthorized, r.StatusCode)
+	}
+
+	client.RotateSecretKey("sk_new")
+	r, err = client.Transactions.Verify("ref")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, r.StatusCode)
+	}
+}
+
+func TestSecondarySecretKeyRetriesUnauthorized(t *testing.T) {
+	server := newTestServer(t, "sk_old")
+	client := NewAPIClient(WithSecretKey("sk_new"), WithSecondarySecretKey("sk_old"), WithBaseUrl(server.URL))
+
+	r, err := client.Customers.Create("johndoe@example.com", "John", "Doe")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, r.StatusCode)
+	}
+	if !strings.Contains(string(r.Data), "sk_old") {
+		t.Fatalf("expected request to be retried with the secondary key, got %s", r.Data)
+	}
+}
+
+func TestWithInsecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status":true}`)
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL), WithInsecureSkipVerify())
+	if _, err := client.Transactions.Verify("ref"); err != nil {
+		t.Fatalf("expected certificate verification to be skipped, got %v", err)
+	}
+
+	client = NewAPIClient(WithSecretKey("sk_test"), WithInsecureSkipVerify())
+	if client.httpClient.Transport != nil {
+		t.Fatal("expected certificate verification to remain enabled for paystack's production host")
+	}
+	if _, err := client.Transactions.Verify("ref"); !errors.Is(err, ErrInsecureSkipVerifyRefused) {
+		t.Fatalf("expected calls to fail with %v for paystack's production host, got %v", ErrInsecureSkipVerifyRefused, err)
+	}
+
+	custom := RoundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		return nil, errors.New("unexpected request")
+	})
+	client = NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL), WithTransport(custom),
+		WithInsecureSkipVerify())
+	if _, err := client.Transactions.Verify("ref"); !errors.Is(err, ErrInsecureSkipVerifyRefused) {
+		t.Fatalf("expected calls to fail with %v for a custom transport, got %v", ErrInsecureSkipVerifyRefused, err)
+	}
+}
+
+func TestEndpointUrl(t *testing.T) {
+	tests := []struct {
+		baseUrl  string
+		basePath string
+		expected string
+	}{
+		{BaseUrl, "", "https://api.paystack.co/transaction/verify/ref"},
+		{"https://gateway.internal/", "/vendor/paystack/", "https://gateway.internal/vendor/paystack/transaction/verify/ref"},
+		{"https://gateway.internal", "vendor/paystack", "https://gateway.internal/vendor/paystack/transaction/verify/ref"},
+	}
+	for _, test := range tests {
+		client := NewAPIClient(WithBaseUrl(test.baseUrl), WithBasePath(test.basePath))
+		if endpointUrl := client.endpointUrl("/transaction/verify/ref"); endpointUrl != test.expected {
+			t.Errorf("expected %q, got %q", test.expected, endpointUrl)
+		}
+	}
+}
+
+func TestWithDryRun(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"status":true,"message":"ok","data":null}`)
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL), WithDryRun())
+
+	r, err := client.Transactions.Initialize(200000, "johndoe@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var body struct {
+		Status bool `json:"status"`
+		Data   struct {
+			Amount int `json:"amount"`
+		} `json:"data"`
+	}
+	if err = r.Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	if requests != 0 || !body.Status || body.Data.Amount != 200000 {
+		t.Fatalf("expected a synthetic response without a request, got %d requests and %s", requests, r.Data)
+	}
+
+	if _, err = client.Transactions.Verify("ref"); err != nil {
+		t.Fatal(err)
+	}
+	if requests != 1 {
+		t.Fatalf("expected read calls to be sent, got %d requests", requests)
+	}
+}
+
+func TestWithDryRunLogsWithLogger(t *testing.T) {
+	var logs bytes.Buffer
+	client := NewAPIClient(WithSecretKey("sk_test"), WithDryRun(),
+		WithLogger(slog.New(slog.NewTextHandler(&logs, nil))))
+	if _, err := client.Transfers.Initiate("balance", 500000, "RCP_gx2wn530m0i3w3m"); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(logs.String(), `msg="paystack dry run" method=POST path=/transfer`) {
+		t.Fatalf("expected the dry run to be logged, got %s", logs.String())
+	}
+}
+
+func TestWithGzipRequests(t *testing.T) {
+	var encodings []string
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encodings = append(encodings, r.Header.Get("Content-Encoding"))
+		var body io.Reader = r.Body
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			reader, err := gzip.NewReader(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			body = reader
+		}
+		content, _ := io.ReadAll(body)
+		bodies = append(bodies, string(content))
+		fmt.Fprint(w, `{"status":true,"message":"ok","data":null}`)
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL), WithGzipRequests(10))
+
+	if _, err := client.BulkCharges.Initiate([]map[string]interface{}{{"authorization": "AUTH_xxx", "amount": 2500}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Transactions.Initialize(200000, "johndoe@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if encodings[0] != "gzip" || bodies[0] != `[{"amount":2500,"authorization":"AUTH_xxx"}]` {
+		t.Fatalf("expected the bulk payload to be compressed, got %q %s", encodings[0], bodies[0])
+	}
+	if encodings[1] != "" {
+		t.Fatalf("expected other payloads not to be compressed, got %q", encodings[1])
+	}
+}
@@ -0,0 +1,181 @@
+package paystack
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// The classes of failures matched by the *APIError of a failed request with errors.Is, based on the status
+// code paystack responded with. The calls of a client return the *APIError of a failed request only if the
+// client was created with WithStrictErrors. Otherwise they return the Response of the failed request, as
+// they always have, and its *APIError is retrieved with Response.AsError.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	resp, err := client.Customers.FetchOne("<email-or-code>")
+//	if err != nil {
+//		panic(err)
+//	}
+//	if errors.Is(resp.AsError(), p.ErrNotFound) {
+//		// create the customer
+//	}
+var (
+	// ErrUnauthorized is matched by the *APIError of a request with a 401 status code
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden is matched by the *APIError of a request with a 403 status code
+	ErrForbidden = errors.New("forbidden")
+	// ErrNotFound is matched by the *APIError of a request with a 404 status code
+	ErrNotFound = errors.New("not found")
+	// ErrValidation is matched by the *APIError of a request paystack rejected as invalid, i.e. with a 400 or
+	// 422 status code or a `validation_error` type
+	ErrValidation = errors.New("validation failed")
+	// ErrRateLimited is matched by the *APIError of a request with a 429 status code and by a *RateLimitError
+	ErrRateLimited = errors.New("rate limited")
+	// ErrServer is matched by the *APIError of a request with a 5xx status code
+	ErrServer = errors.New("paystack server error")
+)
+
+// APIError is a request paystack failed, i.e. it responded with a status code of at least 400 or a `status`
+// of false. It carries the details of the failure paystack returned. It is returned by Response.AsError.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	resp, err := client.Transfers.Initiate("balance", 500000, "RCP_gx2wn530m0i3w3m")
+//	if err != nil {
+//		panic(err)
+//	}
+//	var apiErr *p.APIError
+//	if errors.As(resp.AsError(), &apiErr) {
+//		log.Printf("transfer failed (%s): %s, next step: %s", apiErr.Code, apiErr.Message, apiErr.NextStep)
+//	}
+type APIError struct {
+	// Endpoint is the method and path of the request e.g. `POST /transfer`
+	Endpoint   string
+	StatusCode int
+	// RequestId is the id paystack assigned to the request, if it returned one
+	RequestId string
+	Message   string
+	// Code is the machine readable code of the failure e.g. `insufficient_balance`, if paystack returned one
+	Code string
+	// Type is the category of the failure e.g. `validation_error` or `api_error`, if paystack returned one
+	Type string
+	// Meta is the additional information paystack returned about the failure
+	Meta map[string]interface{}
+	// NextStep is paystack's suggestion to resolve the failure, from the `nextStep` of Meta
+	NextStep string
+	// Response is the response of the failed request
+	Response *Response
+}
+
+func (e *APIError) Error() string {
+	message := e.Message
+	if message == "" {
+		message = http.StatusText(e.StatusCode)
+	}
+	if e.Code != "" {
+		return fmt.Sprintf("paystack: %s: %d %s: %s", e.Endpoint, e.StatusCode, e.Code, message)
+	}
+	return fmt.Sprintf("paystack: %s: %d: %s", e.Endpoint, e.StatusCode, message)
+}
+
+// AsError lets you get the failure of a request paystack failed as an *APIError. nil is returned if the
+// request succeeded, i.e. the status code of the Response is below 400 and its `status` is not false.
+func (r *Response) AsError() error {
+	if r == nil {
+		return nil
+	}
+	var body struct {
+		Status  *bool                  `json:"status"`
+		Message string                 `json:"message"`
+		Code    string                 `json:"code"`
+		Type    string                 `json:"type"`
+		Meta    map[string]interface{} `json:"meta"`
+	}
+	isJSON := json.Unmarshal(r.Data, &body) == nil
+	failed := r.StatusCode >= http.StatusBadRequest || (isJSON && body.Status != nil && !*body.Status)
+	if !failed {
+		return nil
+	}
+	apiErr := &APIError{
+		Endpoint:   r.endpoint,
+		StatusCode: r.StatusCode,
+		RequestId:  r.RequestId,
+		Message:    body.Message,
+		Code:       body.Code,
+		Type:       body.Type,
+		Meta:       body.Meta,
+		Response:   r,
+	}
+	if !isJSON {
+		apiErr.Message = strings.TrimSpace(excerpt(r.Data, 0))
+	}
+	for _, key := range []string{"nextStep", "next_step"} {
+		if nextStep, ok := body.Meta[key].(string); ok {
+			apiErr.NextStep = nextStep
+			break
+		}
+	}
+	return apiErr
+}
+
+// class returns the class of failures e belongs to, or nil if it belongs to none
+func (e *APIError) class() error {
+	switch {
+	case e.StatusCode == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case e.StatusCode == http.StatusForbidden:
+		return ErrForbidden
+	case e.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case e.StatusCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case e.StatusCode >= http.StatusInternalServerError:
+		return ErrServer
+	case e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity ||
+		strings.EqualFold(e.Type, "validation_error"):
+		return ErrValidation
+	}
+	return nil
+}
+
+// WithStrictErrors lets you create an APIClient whose calls return an *APIError instead of the Response of
+// a request paystack failed, i.e. responded to with a status code of at least 400 or a `status` of false.
+// This lets you handle failures like any other error, e.g. with errors.Is and ErrNotFound. The Response of
+// the failed request is available as APIError.Response. It is opt-in so that the code of clients that
+// inspect the Response of failed requests keeps working; without it, use Response.AsError.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"), p.WithStrictErrors())
+//	resp, err := client.Transactions.Verify("<reference>")
+//	switch {
+//	case errors.Is(err, p.ErrTransactionNotFound):
+//		// the customer has not paid yet
+//	case err != nil:
+//		panic(err)
+//	}
+func WithStrictErrors() ClientOptions {
+	return func(client *APIClient) {
+		client.strictErrors = true
+	}
+}
+
+// responseOf returns resp, or the Response of err if the request failed with an *APIError because the
+// client has strict errors, so that helpers can inspect failed responses regardless of WithStrictErrors
+func responseOf(resp *Response, err error) *Response {
+	var apiErr *APIError
+	if resp == nil && errors.As(err, &apiErr) {
+		return apiErr.Response
+	}
+	return resp
+}
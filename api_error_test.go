@@ -0,0 +1,110 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestResponseAsError(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		response *Response
+		expected *APIError
+	}{
+		{"success", &Response{StatusCode: http.StatusOK, Data: []byte(`{"status":true,"message":"ok"}`)}, nil},
+		{"not json", &Response{StatusCode: http.StatusOK, Data: []byte("id,amount\n1,100")}, nil},
+		{"failed status", &Response{StatusCode: http.StatusBadRequest, Data: []byte(`{"status":false,
+			"message":"Your balance is not enough to fulfil this request","code":"insufficient_balance",
+			"type":"api_error","meta":{"nextStep":"Top up your balance"}}`), endpoint: "POST /transfer"},
+			&APIError{Endpoint: "POST /transfer", StatusCode: http.StatusBadRequest, Code: "insufficient_balance",
+				Type: "api_error", Message: "Your balance is not enough to fulfil this request",
+				NextStep: "Top up your balance"}},
+		{"false status with ok status code", &Response{StatusCode: http.StatusOK,
+			Data: []byte(`{"status":false,"message":"Invalid reference"}`)},
+			&APIError{StatusCode: http.StatusOK, Message: "Invalid reference"}},
+		{"gateway error", &Response{StatusCode: http.StatusBadGateway, Data: []byte("<html>Bad Gateway</html>")},
+			&APIError{StatusCode: http.StatusBadGateway, Message: "<html>Bad Gateway</html>"}},
+	} {
+		err := c.response.AsError()
+		if c.expected == nil {
+			if err != nil {
+				t.Errorf("%s: expected no error, got %v", c.name, err)
+			}
+			continue
+		}
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Errorf("%s: expected an *APIError, got %v", c.name, err)
+			continue
+		}
+		if apiErr.Endpoint != c.expected.Endpoint || apiErr.StatusCode != c.expected.StatusCode ||
+			apiErr.Code != c.expected.Code || apiErr.Type != c.expected.Type || apiErr.Message != c.expected.Message ||
+			apiErr.NextStep != c.expected.NextStep || apiErr.Response != c.response {
+			t.Errorf("%s: expected %+v, got %+v", c.name, c.expected, apiErr)
+		}
+	}
+}
+
+func TestAPIErrorClasses(t *testing.T) {
+	for statusCode, class := range map[int]error{
+		http.StatusUnauthorized:        ErrUnauthorized,
+		http.StatusForbidden:           ErrForbidden,
+		http.StatusNotFound:            ErrNotFound,
+		http.StatusBadRequest:          ErrValidation,
+		http.StatusUnprocessableEntity: ErrValidation,
+		http.StatusTooManyRequests:     ErrRateLimited,
+		http.StatusServiceUnavailable:  ErrServer,
+	} {
+		err := (&Response{StatusCode: statusCode, Data: []byte(`{"status":false,"message":"failed"}`)}).AsError()
+		if !errors.Is(err, class) {
+			t.Errorf("%d: expected %v, got %v", statusCode, class, err)
+		}
+		if class != ErrServer && errors.Is(err, ErrServer) {
+			t.Errorf("%d: did not expect %v", statusCode, ErrServer)
+		}
+	}
+	if !errors.Is(&RateLimitError{}, ErrRateLimited) {
+		t.Errorf("expected a *RateLimitError to match %v", ErrRateLimited)
+	}
+}
+
+func TestWithStrictErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/customer":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"status":false,"message":"Customer already exists"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/customer/jane@example.com":
+			w.Write([]byte(`{"status":true,"data":{"customer_code":"CUS_1"}}`))
+		case r.Method == http.MethodPut:
+			w.Write([]byte(`{"status":true,"message":"Customer updated"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status":false,"message":"Transaction reference not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	resp, err := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL)).Transactions.Verify("ref")
+	if err != nil || resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected the failed response to be returned by default, got %v %v", resp, err)
+	}
+
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL), WithStrictErrors())
+	resp, err = client.Transactions.Verify("ref")
+	var apiErr *APIError
+	if resp != nil || !errors.As(err, &apiErr) || !errors.Is(err, ErrNotFound) || !errors.Is(err, ErrTransactionNotFound) {
+		t.Fatalf("expected an *APIError, got %v %v", resp, err)
+	}
+	if apiErr.Response == nil || apiErr.Response.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected the failed response to be available, got %+v", apiErr.Response)
+	}
+
+	_, created, err := client.Customers.Upsert(context.Background(), "jane@example.com", "Jane", "Doe")
+	if err != nil || created {
+		t.Fatalf("expected the existing customer to be updated, got %v %v", created, err)
+	}
+}
@@ -1,6 +1,10 @@
 package paystack
 
-import "net/http"
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
 
 // ApplePayClient interacts with endpoints related to paystack Apple Pay resource that
 // lets you register your application's top-level domain or subdomain.
@@ -133,3 +137,39 @@ func (a *ApplePayClient) Unregister(domainName string) (*Response, error) {
 	payload["domainName"] = domainName
 	return a.APICall(http.MethodDelete, "/apple-pay/domain", payload)
 }
+
+// RegisterMany lets you register multiple top-level domains or subdomains for Apple Pay at the same time.
+// The responses are returned in the order of domainNames. How many domains are registered at the same
+// time and how errors are handled is configured with options.
+//
+// Example:
+//
+//	import (
+//		"context"
+//		p "github.com/gray-adeyi/paystack"
+//	)
+//
+//	applePayClient := p.NewApplePayClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// Alternatively, you can access an apple pay client from an APIClient
+//	// paystackClient := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// paystackClient.ApplePay field is a `ApplePayClient`
+//	// Therefore, this is possible
+//	// responses, err := paystackClient.ApplePay.RegisterMany(context.TODO(), domainNames, p.ParallelOptions{})
+//
+//	domainNames := []string{"example.com", "shop.example.com"}
+//	responses, err := applePayClient.RegisterMany(context.TODO(), domainNames, p.ParallelOptions{})
+//	if err != nil {
+//		panic(err)
+//	}
+func (a *ApplePayClient) RegisterMany(ctx context.Context, domainNames []string, options ParallelOptions) ([]*Response, error) {
+	responses := make([]*Response, len(domainNames))
+	_, err := parallel(ctx, len(domainNames), options, func(ctx context.Context, i int) error {
+		resp, err := a.Register(domainNames[i])
+		if err != nil {
+			return fmt.Errorf("unable to register domain %s: %w", domainNames[i], err)
+		}
+		responses[i] = resp
+		return nil
+	})
+	return responses, err
+}
@@ -0,0 +1,172 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Authorization is a card authorization of a customer, e.g. an item of the `authorizations` of the
+// response of CustomerClient.FetchOne
+type Authorization struct {
+	AuthorizationCode string `json:"authorization_code"`
+	Last4             string `json:"last4"`
+	ExpMonth          string `json:"exp_month"`
+	ExpYear           string `json:"exp_year"`
+	CardType          string `json:"card_type"`
+	Bank              string `json:"bank"`
+	Brand             string `json:"brand"`
+	Reusable          bool   `json:"reusable"`
+	Signature         string `json:"signature"`
+}
+
+// ExpiresAt returns the time the card of an Authorization expires which is the end of its expiry month.
+// false is returned if the expiry month or year could not be parsed.
+func (a Authorization) ExpiresAt() (time.Time, bool) {
+	month, err := strconv.Atoi(a.ExpMonth)
+	if err != nil || month < 1 || month > 12 {
+		return time.Time{}, false
+	}
+	year, err := strconv.Atoi(a.ExpYear)
+	if err != nil {
+		return time.Time{}, false
+	}
+	if year < 100 {
+		year += 2000
+	}
+	return time.Date(year, time.Month(month)+1, 1, 0, 0, 0, 0, time.UTC), true
+}
+
+// ExpiringAuthorizations returns the authorizations whose card expires within the next months from now,
+// including the ones that have already expired. Authorizations whose expiry could not be determined are
+// left out.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	expiring := p.ExpiringAuthorizations(storedAuthorizations, 2, time.Now())
+func ExpiringAuthorizations(authorizations []Authorization, months int, now time.Time) []Authorization {
+	deadline := now.AddDate(0, months, 0)
+	var expiring []Authorization
+	for _, authorization := range authorizations {
+		expiresAt, ok := authorization.ExpiresAt()
+		if ok && !expiresAt.After(deadline) {
+			expiring = append(expiring, authorization)
+		}
+	}
+	return expiring
+}
+
+// CardUpdatePrompt contains the link a customer whose card is expiring can use to update the card of
+// a subscription. It is returned by APIClient.CardUpdatePrompts.
+type CardUpdatePrompt struct {
+	CustomerCode     string
+	Email            string
+	SubscriptionCode string
+	// Authorizations are the expiring authorizations of the customer
+	Authorizations []Authorization
+	// Link is the link to the page for managing the subscription
+	Link string
+}
+
+// CardUpdatePrompts lets you find the customers whose cards expire within the next months and generate
+// the links to manage their active subscriptions, so that they can be prompted to update their card before
+// a charge fails. customers are the emails or codes of the customers to check. How many customers are
+// checked at the same time and how errors are handled is configured with options.
+//
+// Example
+//
+//	import (
+//		"context"
+//		p "github.com/gray-adeyi/paystack"
+//	)
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	prompts, err := client.CardUpdatePrompts(context.TODO(), []string{"CUS_xnxdt6s1zg1f4nx"}, 1, p.ParallelOptions{})
+//	if err != nil {
+//		panic(err)
+//	}
+//	for _, prompt := range prompts {
+//		fmt.Printf("send %s to %s\n", prompt.Link, prompt.Email)
+//	}
+func (a *APIClient) CardUpdatePrompts(ctx context.Context, customers []string, months int, options ParallelOptions) ([]CardUpdatePrompt, error) {
+	now := time.Now()
+	var mu sync.Mutex
+	var prompts []CardUpdatePrompt
+	_, err := parallel(ctx, len(customers), options, func(ctx context.Context, i int) error {
+		customerPrompts, err := a.cardUpdatePrompts(customers[i], months, now)
+		if err != nil {
+			return fmt.Errorf("unable to check customer %s: %w", customers[i], err)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		prompts = append(prompts, customerPrompts...)
+		return nil
+	})
+	return prompts, err
+}
+
+func (a *APIClient) cardUpdatePrompts(emailOrCode string, months int, now time.Time) ([]CardUpdatePrompt, error) {
+	resp, err := a.Customers.FetchOne(emailOrCode)
+	if err != nil {
+		return nil, err
+	}
+	var customer struct {
+		Status  bool   `json:"status"`
+		Message string `json:"message"`
+		Data    struct {
+			CustomerCode   string          `json:"customer_code"`
+			Email          string          `json:"email"`
+			Authorizations []Authorization `json:"authorizations"`
+			Subscriptions  []struct {
+				SubscriptionCode string `json:"subscription_code"`
+				Status           string `json:"status"`
+			} `json:"subscriptions"`
+		} `json:"data"`
+	}
+	if err = resp.Decode(&customer); err != nil {
+		return nil, err
+	}
+	if !customer.Status {
+		return nil, fmt.Errorf("unable to fetch customer: %s", customer.Message)
+	}
+	expiring := ExpiringAuthorizations(customer.Data.Authorizations, months, now)
+	if len(expiring) == 0 {
+		return nil, nil
+	}
+
+	var prompts []CardUpdatePrompt
+	for _, subscription := range customer.Data.Subscriptions {
+		if subscription.Status != "active" && subscription.Status != "attention" {
+			continue
+		}
+		resp, err = a.Subscriptions.GenerateLink(subscription.SubscriptionCode)
+		if err != nil {
+			return nil, err
+		}
+		var link struct {
+			Status  bool   `json:"status"`
+			Message string `json:"message"`
+			Data    struct {
+				Link string `json:"link"`
+			} `json:"data"`
+		}
+		if err = resp.Decode(&link); err != nil {
+			return nil, err
+		}
+		if !link.Status {
+			return nil, fmt.Errorf("unable to generate link for subscription %s: %s", subscription.SubscriptionCode, link.Message)
+		}
+		prompts = append(prompts, CardUpdatePrompt{
+			CustomerCode:     customer.Data.CustomerCode,
+			Email:            customer.Data.Email,
+			SubscriptionCode: subscription.SubscriptionCode,
+			Authorizations:   expiring,
+			Link:             link.Data.Link,
+		})
+	}
+	return prompts, nil
+}
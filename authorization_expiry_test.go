@@ -0,0 +1,27 @@
+package paystack
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExpiringAuthorizations(t *testing.T) {
+	now := time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC)
+	authorizations := []Authorization{
+		{AuthorizationCode: "AUTH_expired", ExpMonth: "01", ExpYear: "2024"},
+		{AuthorizationCode: "AUTH_this_month", ExpMonth: "03", ExpYear: "2024"},
+		{AuthorizationCode: "AUTH_next_month", ExpMonth: "4", ExpYear: "24"},
+		{AuthorizationCode: "AUTH_later", ExpMonth: "12", ExpYear: "2030"},
+		{AuthorizationCode: "AUTH_unknown", ExpMonth: "", ExpYear: "2024"},
+	}
+	expiring := ExpiringAuthorizations(authorizations, 2, now)
+	want := []string{"AUTH_expired", "AUTH_this_month", "AUTH_next_month"}
+	if len(expiring) != len(want) {
+		t.Fatalf("expected %d expiring authorizations, got %+v", len(want), expiring)
+	}
+	for i, authorization := range expiring {
+		if authorization.AuthorizationCode != want[i] {
+			t.Errorf("expected %s, got %s", want[i], authorization.AuthorizationCode)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package paystack
+
+import (
+	"context"
+	"time"
+)
+
+// CallOption is an option of a single call e.g. WithRequestTimeout or WithIdempotencyKey. Call options are
+// carried by the context of a call, which is created with WithCallOptions, and applied when the request of
+// the call is made, rather than sent in its payload. They apply to the methods of the client that take a
+// context e.g. TransactionClient.VerifyContext, TransactionClient.InitializeContext and APIClient.Do.
+type CallOption = func(options *callOptions)
+
+// callOptions are the options of the calls made with a context
+type callOptions struct {
+	timeout        time.Duration
+	idempotencyKey string
+}
+
+type callOptionsKey struct{}
+
+// WithCallOptions lets you create a context that applies options to the calls made with it. The options of
+// ctx, if it was created with WithCallOptions, are kept unless they are replaced by options.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	ctx := p.WithCallOptions(r.Context(), p.WithRequestTimeout(5*time.Second))
+//	resp, err := client.Transactions.VerifyContext(ctx, "<reference>")
+func WithCallOptions(ctx context.Context, options ...CallOption) context.Context {
+	o := callOptionsFrom(ctx)
+	for _, option := range options {
+		option(&o)
+	}
+	return context.WithValue(ctx, callOptionsKey{}, o)
+}
+
+// callOptionsFrom returns the options of the calls made with ctx
+func callOptionsFrom(ctx context.Context) callOptions {
+	o, _ := ctx.Value(callOptionsKey{}).(callOptions)
+	return o
+}
@@ -0,0 +1,80 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ErrChargeStillPending is returned by ChargeClient.AwaitFinalStatus when a charge is still pending
+// after its ctx is done
+var ErrChargeStillPending = errors.New("charge is still pending")
+
+// minChargeStatusWait is the shortest interval between the checks of AwaitFinalStatus, so that a minWait of
+// 0 does not check the status of a charge in a tight loop
+var minChargeStatusWait = time.Second
+
+// AwaitFinalStatus lets you wait for a charge whose status is `pending` to leave the pending status, as
+// recommended by paystack. The status of the charge is first checked after minWait, which paystack
+// recommends to be at least 10 seconds, and then at intervals that double on every check up to maxWait.
+// The response of the first check whose status is not `pending` is returned. An error wrapping
+// ErrChargeStillPending and the error of ctx is returned if the charge is still pending when ctx is done.
+// minWait is raised to a second if it is shorter, and maxWait to minWait if it is shorter.
+//
+// Example
+//
+//	import (
+//		"context"
+//		"time"
+//		p "github.com/gray-adeyi/paystack"
+//	)
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+//	defer cancel()
+//	resp, err := client.Charges.AwaitFinalStatus(ctx, "<reference>", 10*time.Second, time.Minute)
+//	if errors.Is(err, p.ErrChargeStillPending) {
+//		// check the charge again later e.g. from a background job
+//	}
+func (c *ChargeClient) AwaitFinalStatus(ctx context.Context, reference string, minWait time.Duration, maxWait time.Duration) (*Response, error) {
+	if minWait < minChargeStatusWait {
+		minWait = minChargeStatusWait
+	}
+	if maxWait < minWait {
+		maxWait = minWait
+	}
+	wait := minWait
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w: %w", ErrChargeStillPending, ctx.Err())
+		case <-timer.C:
+		}
+		resp, err := c.apiCall(ctx, http.MethodGet, fmt.Sprintf("/charge/%s", reference), nil)
+		if errors.Is(err, ErrRequestCanceled) {
+			return nil, fmt.Errorf("%w: %w", ErrChargeStillPending, err)
+		}
+		if err != nil {
+			return nil, err
+		}
+		var charge struct {
+			Data struct {
+				Status string `json:"status"`
+			} `json:"data"`
+		}
+		if err = resp.Decode(&charge); err != nil {
+			return resp, err
+		}
+		if charge.Data.Status != "pending" {
+			return resp, nil
+		}
+		if wait *= 2; wait > maxWait {
+			wait = maxWait
+		}
+		timer.Reset(wait)
+	}
+}
@@ -0,0 +1,77 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAwaitFinalStatus(t *testing.T) {
+	defer func(wait time.Duration) { minChargeStatusWait = wait }(minChargeStatusWait)
+	minChargeStatusWait = time.Millisecond
+	var checks int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := "pending"
+		if atomic.AddInt32(&checks, 1) == 3 {
+			status = "success"
+		}
+		fmt.Fprintf(w, `{"status":true,"message":"Charge attempted","data":{"status":%q}}`, status)
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	resp, err := client.Charges.AwaitFinalStatus(context.Background(), "ref", time.Millisecond, 2*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(&checks); got != 3 {
+		t.Fatalf("expected 3 checks, got %d", got)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+}
+
+func TestAwaitFinalStatusTimeout(t *testing.T) {
+	defer func(wait time.Duration) { minChargeStatusWait = wait }(minChargeStatusWait)
+	minChargeStatusWait = time.Millisecond
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":true,"message":"Charge attempted","data":{"status":"pending"}}`))
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := client.Charges.AwaitFinalStatus(ctx, "ref", time.Millisecond, 2*time.Millisecond)
+	if !errors.Is(err, ErrChargeStillPending) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a pending charge timeout, got %v", err)
+	}
+}
+
+func TestAwaitFinalStatusClampsWaits(t *testing.T) {
+	defer func(wait time.Duration) { minChargeStatusWait = wait }(minChargeStatusWait)
+	minChargeStatusWait = 10 * time.Millisecond
+	var checks int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&checks, 1)
+		w.Write([]byte(`{"status":true,"message":"Charge attempted","data":{"status":"pending"}}`))
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := client.Charges.AwaitFinalStatus(ctx, "ref", 0, -time.Second)
+	if !errors.Is(err, ErrChargeStillPending) {
+		t.Fatalf("expected a pending charge timeout, got %v", err)
+	}
+	if got := atomic.LoadInt32(&checks); got > 10 {
+		t.Fatalf("expected the checks to be at least %s apart, got %d checks", minChargeStatusWait, got)
+	}
+}
@@ -1,6 +1,7 @@
 package paystack
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -24,6 +25,9 @@ func NewChargeClient(options ...ClientOptions) *ChargeClient {
 }
 
 // Create lets you initiate a payment by integrating the payment channel of your choice.
+// If a `reference` optional parameter is not provided, a reference is generated with GenerateReference
+// and it can be retrieved from the response. When paystack rejects a generated reference as a duplicate,
+// the charge is retried once with a fresh reference. A reference you provide is never retried.
 //
 // Example:
 //
@@ -62,6 +66,23 @@ func NewChargeClient(options ...ClientOptions) *ChargeClient {
 //	}
 //	fmt.Println(data)
 func (c *ChargeClient) Create(email string, amount string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error) {
+	return c.CreateContext(context.Background(), email, amount, optionalPayloadParameters...)
+}
+
+// CreateContext lets you initiate a payment like Create, with ctx. The call is canceled when ctx is done
+// and the CallOption of ctx e.g. WithIdempotencyKey are applied to it. When the charge is retried with a
+// fresh reference, the retry is sent with the idempotency key suffixed with the new reference, since the
+// key of the first request would replay its response.
+//
+// Example:
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	ctx = p.WithCallOptions(ctx, p.WithIdempotencyKey("order-"+order.Id))
+//	resp, err := client.Charges.CreateContext(ctx, "johndoe@example.com", "100000",
+//		p.WithOptionalParameter("authorization_code", "AUTH_xxx"))
+func (c *ChargeClient) CreateContext(ctx context.Context, email string, amount string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error) {
 	payload := make(map[string]interface{})
 	payload["email"] = email
 	payload["amount"] = amount
@@ -70,7 +91,21 @@ func (c *ChargeClient) Create(email string, amount string, optionalPayloadParame
 		payload = optionalPayloadParameter(payload)
 	}
 
-	return c.APICall(http.MethodPost, "/charge", payload)
+	_, hasReference := payload["reference"]
+	if !hasReference {
+		payload["reference"] = GenerateReference()
+	}
+	resp, err := c.apiCall(ctx, http.MethodPost, "/charge", payload)
+	if hasReference || !isDuplicateReference(responseOf(resp, err)) {
+		return resp, err
+	}
+	reference := GenerateReference()
+	payload["reference"] = reference
+	// the key of the first request would replay its response, so the retry gets its own key
+	if key := callOptionsFrom(ctx).idempotencyKey; key != "" {
+		ctx = WithCallOptions(ctx, WithIdempotencyKey(key+":"+reference))
+	}
+	return c.apiCall(ctx, http.MethodPost, "/charge", payload)
 }
 
 // SubmitPin lets you submit pin to continue a charge
@@ -112,6 +147,44 @@ func (c *ChargeClient) SubmitPin(pin string, reference string) (*Response, error
 	return c.APICall(http.MethodPost, "/charge/submit_pin", payload)
 }
 
+// SubmitOTP lets you submit the OTP sent to a customer to continue a charge
+//
+// Example:
+//
+//	import (
+//		"fmt"
+//		p "github.com/gray-adeyi/paystack"
+//		"encoding/json"
+//	)
+//
+//	chargeClient := p.NewChargeClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// Alternatively, you can access the charge client from an APIClient
+//	// paystackClient := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// paystackClient.Charges field is a `ChargeClient`
+//	// Therefore, this is possible
+//	// resp, err := paystackClient.Charges.SubmitOTP("123456", "5bwib5v6anhe9xa")
+//
+//	resp, err := chargeClient.SubmitOTP("123456", "5bwib5v6anhe9xa")
+//	if err != nil {
+//		panic(err)
+//	}
+//	// you can have data be a custom structure based on the data your interested in retrieving from
+//	// from paystack for simplicity, we're using `map[string]interface{}` which is sufficient to
+//	// to serialize the json data returned by paystack
+//	data := make(map[string]interface{})
+//
+//	err := json.Unmarshal(resp.Data, &data); if err != nil {
+//		panic(err)
+//	}
+//	fmt.Println(data)
+func (c *ChargeClient) SubmitOTP(otp string, reference string) (*Response, error) {
+	payload := make(map[string]interface{})
+	payload["otp"] = otp
+	payload["reference"] = reference
+
+	return c.APICall(http.MethodPost, "/charge/submit_otp", payload)
+}
+
 // SubmitPhone lets you submit phone number when requested
 //
 // Example:
@@ -0,0 +1,264 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrChargeSessionNotFound is returned by a ChargeSessionStore when a session does not exist for a reference
+var ErrChargeSessionNotFound = errors.New("charge session not found")
+
+// ChargeSession is the state of a charge that requires more than one step to complete e.g. a card charge
+// that requires the customer's pin and then an OTP.
+type ChargeSession struct {
+	Reference string
+	// Status is the status of the charge as returned by paystack e.g. `send_pin`, `send_otp`, `success`
+	Status string
+	// DisplayText is the message paystack recommends showing the customer for the next step
+	DisplayText string
+	// Url is the url the customer should be redirected to when Status is `open_url`
+	Url string
+	// Domain is the Domain of the secret key the charge was created with
+	Domain    Domain
+	UpdatedAt time.Time
+}
+
+// Completed checks if no further action can be taken on the charge of a ChargeSession
+func (s ChargeSession) Completed() bool {
+	return isFinalTransactionStatus(s.Status)
+}
+
+// ChargeSessionStore is implemented by types that persist ChargeSession. Implementing ChargeSessionStore
+// with a shared store lets a ChargeFlow survive process restarts and be used across multiple instances
+// of a stateless web backend.
+//
+// Example of a ChargeSessionStore backed by redis using github.com/redis/go-redis/v9
+//
+//	type RedisChargeSessionStore struct {
+//		client *redis.Client
+//	}
+//
+//	func (s *RedisChargeSessionStore) Save(session p.ChargeSession) error {
+//		data, err := json.Marshal(session)
+//		if err != nil {
+//			return err
+//		}
+//		return s.client.Set(context.TODO(), "charge:"+session.Reference, data, time.Hour).Err()
+//	}
+//
+//	func (s *RedisChargeSessionStore) Load(reference string) (p.ChargeSession, error) {
+//		var session p.ChargeSession
+//		data, err := s.client.Get(context.TODO(), "charge:"+reference).Bytes()
+//		if errors.Is(err, redis.Nil) {
+//			return session, p.ErrChargeSessionNotFound
+//		}
+//		if err != nil {
+//			return session, err
+//		}
+//		err = json.Unmarshal(data, &session)
+//		return session, err
+//	}
+//
+//	func (s *RedisChargeSessionStore) Delete(reference string) error {
+//		return s.client.Del(context.TODO(), "charge:"+reference).Err()
+//	}
+type ChargeSessionStore interface {
+	Save(session ChargeSession) error
+	// Load should return ErrChargeSessionNotFound if a session does not exist for the reference
+	Load(reference string) (ChargeSession, error)
+	Delete(reference string) error
+}
+
+// MemoryChargeSessionStore is a ChargeSessionStore that keeps sessions in memory. It is the default
+// ChargeSessionStore of a ChargeFlow.
+type MemoryChargeSessionStore struct {
+	mu       sync.RWMutex
+	sessions map[string]ChargeSession
+}
+
+// NewMemoryChargeSessionStore creates a MemoryChargeSessionStore
+func NewMemoryChargeSessionStore() *MemoryChargeSessionStore {
+	return &MemoryChargeSessionStore{sessions: make(map[string]ChargeSession)}
+}
+
+func (m *MemoryChargeSessionStore) Save(session ChargeSession) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sessions[session.Reference] = session
+	return nil
+}
+
+func (m *MemoryChargeSessionStore) Load(reference string) (ChargeSession, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	session, ok := m.sessions[reference]
+	if !ok {
+		return session, ErrChargeSessionNotFound
+	}
+	return session, nil
+}
+
+func (m *MemoryChargeSessionStore) Delete(reference string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.sessions, reference)
+	return nil
+}
+
+// ChargeFlow helps you drive a charge through the steps paystack requests (pin, otp, phone, birthday,
+// address) while persisting the state of the charge in a ChargeSessionStore between steps.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	flow := p.NewChargeFlow(client.Charges, nil)
+//	session, _, err := flow.Start(ctx, "johndoe@example.com", "100000", p.WithOptionalParameter("card", card))
+//	if err != nil {
+//		panic(err)
+//	}
+//	// later, possibly in another request handler, when the customer provides the requested input
+//	session, _, err = flow.Continue(ctx, session.Reference, "1234")
+type ChargeFlow struct {
+	client *ChargeClient
+	store  ChargeSessionStore
+}
+
+// NewChargeFlow creates a ChargeFlow. If store is nil, a MemoryChargeSessionStore is used.
+func NewChargeFlow(client *ChargeClient, store ChargeSessionStore) *ChargeFlow {
+	if store == nil {
+		store = NewMemoryChargeSessionStore()
+	}
+	return &ChargeFlow{client: client, store: store}
+}
+
+// Start lets you create a charge with ChargeClient.CreateContext and persist its session. The session and
+// the response of creating the charge are returned.
+func (f *ChargeFlow) Start(ctx context.Context, email string, amount string, optionalPayloadParameters ...OptionalPayloadParameter) (ChargeSession, *Response, error) {
+	domain, err := f.domain(ctx)
+	if err != nil {
+		return ChargeSession{}, nil, err
+	}
+	resp, err := f.client.CreateContext(ctx, email, amount, optionalPayloadParameters...)
+	if err != nil {
+		return ChargeSession{}, nil, err
+	}
+	session, err := f.update(resp, domain)
+	return session, resp, err
+}
+
+// Continue lets you submit the input requested by paystack for the charge with the reference. The input
+// is submitted based on the status of the charge's session e.g. it is submitted as a pin when the status
+// is `send_pin`. Charges with the `send_address` status should be continued with ContinueWithAddress.
+func (f *ChargeFlow) Continue(ctx context.Context, reference string, input string) (ChargeSession, *Response, error) {
+	session, domain, err := f.load(ctx, reference)
+	if err != nil {
+		return session, nil, err
+	}
+
+	var resp *Response
+	switch session.Status {
+	case "send_pin", "send_otp", "send_phone", "send_birthday":
+		// the input is submitted as the field the status asks for e.g. `pin` to /charge/submit_pin
+		field := strings.TrimPrefix(session.Status, "send_")
+		resp, err = f.client.apiCall(ctx, http.MethodPost, "/charge/submit_"+field,
+			map[string]interface{}{field: input, "reference": reference})
+	case "pending", "open_url":
+		resp, err = f.client.apiCall(ctx, http.MethodGet, fmt.Sprintf("/charge/%s", reference), nil)
+	default:
+		return session, nil, fmt.Errorf("charge %s with status %q can not be continued with an input", reference, session.Status)
+	}
+	if err != nil {
+		return session, nil, err
+	}
+	session, err = f.update(resp, domain)
+	return session, resp, err
+}
+
+// ContinueWithAddress lets you submit the address requested by paystack for the charge with the reference
+func (f *ChargeFlow) ContinueWithAddress(ctx context.Context, reference string, address string, city string, state string, zipCode string) (ChargeSession, *Response, error) {
+	session, domain, err := f.load(ctx, reference)
+	if err != nil {
+		return session, nil, err
+	}
+	if session.Status != "send_address" {
+		return session, nil, fmt.Errorf("charge %s with status %q does not require an address", reference, session.Status)
+	}
+	resp, err := f.client.apiCall(ctx, http.MethodPost, "/charge/submit_address", map[string]interface{}{
+		"address":   address,
+		"reference": reference,
+		"city":      city,
+		"state":     state,
+		"zipcode":   zipCode,
+	})
+	if err != nil {
+		return session, nil, err
+	}
+	session, err = f.update(resp, domain)
+	return session, resp, err
+}
+
+// domain returns the Domain of the secret key of the ChargeFlow's client
+func (f *ChargeFlow) domain(ctx context.Context) (Domain, error) {
+	secretKey, _, err := f.client.secretKeys(ctx)
+	if err != nil {
+		return "", err
+	}
+	return DomainFromSecretKey(secretKey), nil
+}
+
+// load loads the session of the charge with the reference alongside the Domain of the secret key of the
+// ChargeFlow's client, refusing to continue a session that was started with a secret key of a different
+// Domain
+func (f *ChargeFlow) load(ctx context.Context, reference string) (ChargeSession, Domain, error) {
+	session, err := f.store.Load(reference)
+	if err != nil {
+		return session, "", err
+	}
+	domain, err := f.domain(ctx)
+	if err != nil {
+		return session, "", err
+	}
+	if session.Domain != "" && domain != "" && session.Domain != domain {
+		return session, "", fmt.Errorf("%w: %s charge session %s continued with a %s secret key",
+			ErrDomainMismatch, session.Domain, session.Reference, domain)
+	}
+	return session, domain, nil
+}
+
+// update persists the session described by the response of a charge step taken with a secret key of
+// domain. Sessions of completed charges are removed from the store.
+func (f *ChargeFlow) update(resp *Response, domain Domain) (ChargeSession, error) {
+	var charge struct {
+		Data struct {
+			Reference   string `json:"reference"`
+			Status      string `json:"status"`
+			DisplayText string `json:"display_text"`
+			Url         string `json:"url"`
+		} `json:"data"`
+	}
+	if err := resp.Decode(&charge); err != nil {
+		return ChargeSession{}, err
+	}
+	session := ChargeSession{
+		Reference:   charge.Data.Reference,
+		Status:      charge.Data.Status,
+		DisplayText: charge.Data.DisplayText,
+		Url:         charge.Data.Url,
+		Domain:      domain,
+		UpdatedAt:   time.Now(),
+	}
+	if session.Reference == "" {
+		return session, nil
+	}
+	if session.Completed() {
+		return session, f.store.Delete(session.Reference)
+	}
+	return session, f.store.Save(session)
+}
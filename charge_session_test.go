@@ -0,0 +1,144 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMemoryChargeSessionStore(t *testing.T) {
+	store := NewMemoryChargeSessionStore()
+	if _, err := store.Load("ref"); !errors.Is(err, ErrChargeSessionNotFound) {
+		t.Fatalf("expected %v, got %v", ErrChargeSessionNotFound, err)
+	}
+	if err := store.Save(ChargeSession{Reference: "ref", Status: "send_pin"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Save(ChargeSession{Reference: "ref", Status: "send_otp"}); err != nil {
+		t.Fatal(err)
+	}
+	if session, err := store.Load("ref"); err != nil || session.Status != "send_otp" {
+		t.Fatalf("expected the last saved session, got %+v %v", session, err)
+	}
+	if err := store.Delete("ref"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Load("ref"); !errors.Is(err, ErrChargeSessionNotFound) {
+		t.Fatalf("expected a deleted session to be not found, got %v", err)
+	}
+}
+
+// newChargeFlowServer returns a server that asks for a pin and then an otp before a charge succeeds,
+// recording the requests it received
+func newChargeFlowServer(t *testing.T, requests *[]string) *httptest.Server {
+	statuses := map[string]string{
+		"/charge":            "send_pin",
+		"/charge/submit_pin": "send_otp",
+		"/charge/submit_otp": "success",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		body, _ := json.Marshal(payload)
+		*requests = append(*requests, r.URL.Path+" "+string(body))
+		status, ok := statuses[r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.Write([]byte(`{"status":true,"data":{"reference":"ref","status":"` + status + `"}}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestChargeFlow(t *testing.T) {
+	var requests []string
+	server := newChargeFlowServer(t, &requests)
+	client := NewAPIClient(WithSecretKey("sk_test_key"), WithBaseUrl(server.URL))
+	store := NewMemoryChargeSessionStore()
+	flow := NewChargeFlow(client.Charges, store)
+	ctx := context.Background()
+
+	session, _, err := flow.Start(ctx, "johndoe@example.com", "100000", WithOptionalParameter("reference", "ref"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.Status != "send_pin" || session.Domain != DomainTest {
+		t.Fatalf("expected a test session waiting for a pin, got %+v", session)
+	}
+	if _, _, err = flow.ContinueWithAddress(ctx, "ref", "1 Main St", "Lagos", "Lagos", "100001"); err == nil {
+		t.Fatal("expected a session waiting for a pin not to be continued with an address")
+	}
+	if session, _, err = flow.Continue(ctx, "ref", "1234"); err != nil || session.Status != "send_otp" {
+		t.Fatalf("expected the session to wait for an otp, got %+v %v", session, err)
+	}
+	if saved, _ := store.Load("ref"); saved.Status != "send_otp" {
+		t.Fatalf("expected the session to be saved, got %+v", saved)
+	}
+	if session, _, err = flow.Continue(ctx, "ref", "123456"); err != nil || !session.Completed() {
+		t.Fatalf("expected the charge to be completed, got %+v %v", session, err)
+	}
+	if _, err = store.Load("ref"); !errors.Is(err, ErrChargeSessionNotFound) {
+		t.Fatalf("expected the session of a completed charge to be removed, got %v", err)
+	}
+
+	want := []string{
+		`/charge {"amount":"100000","email":"johndoe@example.com","reference":"ref"}`,
+		`/charge/submit_pin {"pin":"1234","reference":"ref"}`,
+		`/charge/submit_otp {"otp":"123456","reference":"ref"}`,
+	}
+	if got := strings.Join(requests, "\n"); got != strings.Join(want, "\n") {
+		t.Fatalf("expected requests\n%s\ngot\n%s", strings.Join(want, "\n"), got)
+	}
+}
+
+func TestChargeFlowDomainMismatch(t *testing.T) {
+	var requests []string
+	server := newChargeFlowServer(t, &requests)
+	client := NewAPIClient(WithSecretKey("sk_test_key"), WithBaseUrl(server.URL))
+	store := NewMemoryChargeSessionStore()
+	store.Save(ChargeSession{Reference: "ref", Status: "send_pin", Domain: DomainLive})
+	flow := NewChargeFlow(client.Charges, store)
+
+	if _, _, err := flow.Continue(context.Background(), "ref", "1234"); !errors.Is(err, ErrDomainMismatch) {
+		t.Fatalf("expected %v, got %v", ErrDomainMismatch, err)
+	}
+	if _, _, err := flow.ContinueWithAddress(context.Background(), "ref", "1 Main St", "Lagos", "Lagos", "100001"); !errors.Is(err, ErrDomainMismatch) {
+		t.Fatalf("expected %v, got %v", ErrDomainMismatch, err)
+	}
+	if len(requests) != 0 {
+		t.Fatalf("expected the live session not to be continued, got %v", requests)
+	}
+}
+
+func TestChargeFlowSecretKeyError(t *testing.T) {
+	var requests []string
+	server := newChargeFlowServer(t, &requests)
+	errUnavailable := errors.New("vault unavailable")
+	type stepKey struct{}
+	var steps []interface{}
+	client := NewAPIClient(WithBaseUrl(server.URL), WithSecretKeyProvider(SecretKeyProviderFunc(func(ctx context.Context) (string, error) {
+		steps = append(steps, ctx.Value(stepKey{}))
+		return "", errUnavailable
+	})))
+	store := NewMemoryChargeSessionStore()
+	store.Save(ChargeSession{Reference: "ref", Status: "send_pin", Domain: DomainTest})
+	flow := NewChargeFlow(client.Charges, store)
+
+	if _, _, err := flow.Start(context.WithValue(context.Background(), stepKey{}, "start"), "johndoe@example.com", "100000"); !errors.Is(err, errUnavailable) {
+		t.Fatalf("expected %v, got %v", errUnavailable, err)
+	}
+	if _, _, err := flow.Continue(context.WithValue(context.Background(), stepKey{}, "continue"), "ref", "1234"); !errors.Is(err, errUnavailable) {
+		t.Fatalf("expected %v, got %v", errUnavailable, err)
+	}
+	if len(steps) != 2 || steps[0] != "start" || steps[1] != "continue" {
+		t.Fatalf("expected the secret key to be retrieved with the ctx of each step, got %v", steps)
+	}
+	if len(requests) != 0 {
+		t.Fatalf("expected no request to be made without a secret key, got %v", requests)
+	}
+}
@@ -0,0 +1,50 @@
+package paystack
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// CheckoutBaseUrl is the base url of paystack's checkout pages
+const CheckoutBaseUrl = "https://checkout.paystack.com"
+
+// ErrInvalidCheckoutUrl is returned by ParseCheckoutURL when a url is not the url of a paystack checkout page
+var ErrInvalidCheckoutUrl = errors.New("invalid paystack checkout url")
+
+// CheckoutURL lets you construct the url of the checkout page of a transaction from its access code, i.e.
+// the `access_code` of the response of TransactionClient.Initialize.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	http.Redirect(w, r, p.CheckoutURL("0peioxfhpn"), http.StatusSeeOther)
+func CheckoutURL(accessCode string) string {
+	return CheckoutBaseUrl + "/" + url.PathEscape(accessCode)
+}
+
+// ParseCheckoutURL lets you retrieve the access code of a transaction from the url of its checkout page,
+// i.e. the inverse of CheckoutURL. ErrInvalidCheckoutUrl is returned if checkoutUrl is not the url of a
+// paystack checkout page.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	accessCode, err := p.ParseCheckoutURL("https://checkout.paystack.com/0peioxfhpn")
+func ParseCheckoutURL(checkoutUrl string) (string, error) {
+	parsedUrl, err := url.Parse(checkoutUrl)
+	if err != nil {
+		return "", ErrInvalidCheckoutUrl
+	}
+	checkoutHost, _ := url.Parse(CheckoutBaseUrl)
+	if parsedUrl.Scheme != checkoutHost.Scheme || !strings.EqualFold(parsedUrl.Hostname(), checkoutHost.Hostname()) {
+		return "", ErrInvalidCheckoutUrl
+	}
+	accessCode := strings.Trim(parsedUrl.Path, "/")
+	if accessCode == "" || strings.Contains(accessCode, "/") {
+		return "", ErrInvalidCheckoutUrl
+	}
+	return accessCode, nil
+}
@@ -0,0 +1,23 @@
+package paystack
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckoutURL(t *testing.T) {
+	checkoutUrl := CheckoutURL("0peioxfhpn")
+	if checkoutUrl != "https://checkout.paystack.com/0peioxfhpn" {
+		t.Fatalf("unexpected checkout url %s", checkoutUrl)
+	}
+	accessCode, err := ParseCheckoutURL(checkoutUrl)
+	if err != nil || accessCode != "0peioxfhpn" {
+		t.Fatalf("expected to parse the access code, got %q: %v", accessCode, err)
+	}
+	for _, invalid := range []string{"", "https://example.com/0peioxfhpn", "http://checkout.paystack.com/0peioxfhpn",
+		"https://checkout.paystack.com/", "https://checkout.paystack.com/a/b"} {
+		if _, err = ParseCheckoutURL(invalid); !errors.Is(err, ErrInvalidCheckoutUrl) {
+			t.Errorf("expected %q to be invalid, got %v", invalid, err)
+		}
+	}
+}
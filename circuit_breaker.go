@@ -0,0 +1,118 @@
+package paystack
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is wrapped by the error of a call rejected without a request being sent because the circuit
+// breaker of the client is open, i.e. paystack failed too many consecutive requests. See WithCircuitBreaker.
+var ErrCircuitOpen = errors.New("paystack circuit breaker is open")
+
+// WithCircuitBreaker lets you create an APIClient that stops sending requests to paystack during an outage.
+// The circuit breaker opens after failureThreshold consecutive requests fail, i.e. they could not be sent or
+// paystack responded with a 5xx status code. While it is open, calls fail immediately with an error wrapping
+// ErrCircuitOpen. After cooldown, a single request is let through to probe paystack, closing the circuit
+// breaker if it succeeds or opening it for another cooldown if it fails.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"), p.WithCircuitBreaker(5, 30*time.Second))
+//	resp, err := client.Transactions.Initialize(200000, "johndoe@example.com")
+//	if errors.Is(err, p.ErrCircuitOpen) {
+//		// paystack is having an incident, offer another payment method
+//	}
+func WithCircuitBreaker(failureThreshold int, cooldown time.Duration) ClientOptions {
+	return func(client *APIClient) {
+		if failureThreshold <= 0 {
+			client.circuitBreaker = nil
+			return
+		}
+		client.circuitBreaker = &circuitBreaker{threshold: failureThreshold, cooldown: cooldown}
+	}
+}
+
+// circuitBreaker counts the consecutive failed requests of a client. It is open when failures reaches
+// threshold and half open, letting a single probe through, once openUntil has passed.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openUntil time.Time
+	probing   bool
+	// probe identifies the latest probe, so that only its outcome closes or reopens the circuit breaker
+	probe uint64
+}
+
+// circuitTicket is returned by circuitBreaker.allow for a request that may be sent. It must be passed to
+// record or release once the outcome of the request is known.
+type circuitTicket struct {
+	// probe is the id of the probe the request is, 0 if the request is not a probe
+	probe uint64
+}
+
+// allow returns an error wrapping ErrCircuitOpen if a request to endpoint may not be sent. A nil
+// circuitBreaker allows every request.
+func (c *circuitBreaker) allow(endpoint string, now time.Time) (circuitTicket, error) {
+	if c == nil {
+		return circuitTicket{}, nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.failures < c.threshold {
+		return circuitTicket{}, nil
+	}
+	if now.Before(c.openUntil) || c.probing {
+		return circuitTicket{}, fmt.Errorf("%w: %s: retry after %s", ErrCircuitOpen, endpoint,
+			c.openUntil.Format(time.RFC3339))
+	}
+	c.probing = true
+	c.probe++
+	return circuitTicket{probe: c.probe}, nil
+}
+
+// record records the outcome of a request that was allowed. A request whose outcome says nothing about the
+// health of paystack, e.g. one canceled by its caller, should be recorded with release instead. While the
+// circuit breaker is open, only the outcome of its probe is recorded, since the outcome of requests that
+// were sent before it opened is stale.
+func (c *circuitBreaker) record(ticket circuitTicket, failed bool, now time.Time) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.failures >= c.threshold && !c.isProbe(ticket) {
+		return
+	}
+	c.probing = false
+	if !failed {
+		c.failures = 0
+		return
+	}
+	c.failures++
+	if c.failures >= c.threshold {
+		c.openUntil = now.Add(c.cooldown)
+	}
+}
+
+// release lets another probe through if the request that was allowed was the probe
+func (c *circuitBreaker) release(ticket circuitTicket) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.isProbe(ticket) {
+		c.probing = false
+	}
+}
+
+// isProbe reports whether ticket is the one of the probe in flight. c.mu must be held.
+func (c *circuitBreaker) isProbe(ticket circuitTicket) bool {
+	return c.probing && ticket.probe != 0 && ticket.probe == c.probe
+}
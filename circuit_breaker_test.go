@@ -0,0 +1,107 @@
+package paystack
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	breaker := &circuitBreaker{threshold: 2, cooldown: time.Minute}
+	now := time.Now()
+	var stale circuitTicket
+	for i := 0; i < 2; i++ {
+		ticket, err := breaker.allow("GET /transaction", now)
+		if err != nil {
+			t.Fatalf("expected the circuit breaker to be closed, got %v", err)
+		}
+		stale = ticket
+		breaker.record(ticket, true, now)
+	}
+	if _, err := breaker.allow("GET /transaction", now); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected %v, got %v", ErrCircuitOpen, err)
+	}
+
+	later := now.Add(2 * time.Minute)
+	probe, err := breaker.allow("GET /transaction", later)
+	if err != nil {
+		t.Fatalf("expected a probe to be let through after the cooldown, got %v", err)
+	}
+	if _, err = breaker.allow("GET /transaction", later); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected a single probe to be let through, got %v", err)
+	}
+	// a request sent before the circuit breaker opened does not end the probe
+	breaker.record(stale, false, later)
+	breaker.release(stale)
+	if _, err = breaker.allow("GET /transaction", later); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected only the probe to close the circuit breaker, got %v", err)
+	}
+	breaker.record(probe, false, later)
+	if _, err = breaker.allow("GET /transaction", later); err != nil {
+		t.Fatalf("expected a successful probe to close the circuit breaker, got %v", err)
+	}
+}
+
+func TestCircuitBreakerProbeSurvivesUnsentRequests(t *testing.T) {
+	var healthy int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&healthy) == 0 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"status":true}`))
+	}))
+	defer server.Close()
+	errSigner := errors.New("signer unavailable")
+	var signerDown int32
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL), WithCircuitBreaker(1, 10*time.Millisecond),
+		WithRequestSigner(RequestSignerFunc(func(request *http.Request) error {
+			if atomic.LoadInt32(&signerDown) == 1 {
+				return errSigner
+			}
+			return nil
+		})))
+
+	if _, err := client.Transactions.Verify("ref"); err != nil {
+		t.Fatalf("expected the failed response to be returned, got %v", err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	atomic.StoreInt32(&signerDown, 1)
+	if _, err := client.Transactions.Verify("ref"); !errors.Is(err, errSigner) {
+		t.Fatalf("expected %v, got %v", errSigner, err)
+	}
+	atomic.StoreInt32(&signerDown, 0)
+	atomic.StoreInt32(&healthy, 1)
+	resp, err := client.Transactions.Verify("ref")
+	if err != nil {
+		t.Fatalf("expected the probe to be let through, got %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+}
+
+func TestWithCircuitBreaker(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL), WithCircuitBreaker(3, time.Minute),
+		WithRetryBudget(5, 60))
+
+	if _, err := client.Transactions.All(); err != nil {
+		t.Fatalf("expected the failed response to be returned, got %v", err)
+	}
+	_, err := client.Transactions.Verify("ref")
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected %v, got %v", ErrCircuitOpen, err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 3 {
+		t.Fatalf("expected 3 requests before the circuit breaker opened, got %d", got)
+	}
+}
@@ -0,0 +1,76 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// errCoalescedRequestPanicked is returned to the callers sharing a coalesced request that panicked
+var errCoalescedRequestPanicked = errors.New("paystack: coalesced request panicked")
+
+// WithGetCoalescing lets you create an APIClient that coalesces identical GET requests, i.e. requests for
+// the same path, made concurrently. Only one of the requests is sent to paystack and its response is shared
+// with the other callers, reducing duplicate traffic during spikes, e.g. many requests of your application
+// listing banks at once.
+//
+// The shared request is made with the context of the first caller. A caller whose context is done stops
+// waiting for the shared response, but the shared request is only aborted if the context of the first
+// caller is done.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"), p.WithGetCoalescing())
+func WithGetCoalescing() ClientOptions {
+	return func(client *APIClient) {
+		client.coalescer = &requestCoalescer{calls: make(map[string]*coalescedCall)}
+	}
+}
+
+// requestCoalescer shares the response of a request between the callers that make it concurrently
+type requestCoalescer struct {
+	mu    sync.Mutex
+	calls map[string]*coalescedCall
+}
+
+type coalescedCall struct {
+	done     chan struct{}
+	response *Response
+	err      error
+}
+
+// do calls send and shares its response with the callers of do with the same key until send returns
+func (c *requestCoalescer) do(ctx context.Context, key string, send func() (*Response, error)) (*Response, error) {
+	c.mu.Lock()
+	call, inFlight := c.calls[key]
+	if !inFlight {
+		call = &coalescedCall{done: make(chan struct{})}
+		c.calls[key] = call
+	}
+	c.mu.Unlock()
+
+	if !inFlight {
+		defer func() {
+			c.mu.Lock()
+			delete(c.calls, key)
+			c.mu.Unlock()
+			close(call.done)
+		}()
+		// the error is reported to the other callers if send panics
+		call.err = errCoalescedRequestPanicked
+		call.response, call.err = send()
+		return call.response, call.err
+	}
+	select {
+	case <-ctx.Done():
+		return nil, requestError(ctx, "GET "+key, ctx.Err())
+	case <-call.done:
+	}
+	if call.err != nil {
+		return nil, call.err
+	}
+	// every caller gets its own Response so that modifying it does not affect the others
+	return call.response.clone(), nil
+}
@@ -0,0 +1,58 @@
+package paystack
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestWithGetCoalescing(t *testing.T) {
+	var requests int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-release
+		w.Write([]byte(`{"status":true,"message":"Banks retrieved","data":[]}`))
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL), WithGetCoalescing())
+
+	const callers = 10
+	var wg sync.WaitGroup
+	responses := make([]*Response, callers)
+	errs := make([]error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			responses[i], errs[i] = client.Miscellaneous.Banks()
+		}(i)
+	}
+	// wait for the first request to reach the server before letting it respond
+	for atomic.LoadInt32(&requests) == 0 {
+	}
+	for {
+		client.coalescer.mu.Lock()
+		inFlight := len(client.coalescer.calls)
+		client.coalescer.mu.Unlock()
+		if inFlight == 1 {
+			break
+		}
+	}
+	close(release)
+	wg.Wait()
+
+	for i := 0; i < callers; i++ {
+		if errs[i] != nil || responses[i].StatusCode != http.StatusOK {
+			t.Fatalf("unexpected result of caller %d: %v", i, errs[i])
+		}
+	}
+	if got := atomic.LoadInt32(&requests); got >= callers {
+		t.Fatalf("expected concurrent requests to be coalesced, got %d requests", got)
+	}
+	if responses[0] == responses[1] {
+		t.Fatal("expected every caller to get its own response")
+	}
+}
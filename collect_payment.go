@@ -0,0 +1,102 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+)
+
+// collectionCurrencies are the currencies paystack accepts payments in
+var collectionCurrencies = map[string]bool{
+	"NGN": true, "GHS": true, "ZAR": true, "KES": true, "USD": true, "XOF": true, "EGP": true, "RWF": true,
+}
+
+// ErrInvalidPayment is returned by CollectPayment when its arguments are not valid
+var ErrInvalidPayment = errors.New("invalid payment")
+
+// CollectedPayment is the result of CollectPayment
+type CollectedPayment struct {
+	// AuthorizationUrl is the url of the checkout page the customer should be redirected to
+	AuthorizationUrl string
+	AccessCode       string
+	Reference        string
+}
+
+// CollectPayment lets you start collecting a payment in one call, for bootstrapping new integrations. The
+// arguments are validated, a reference is generated with GenerateReference, the `metadata` of the transaction
+// is tagged with the version of the SDK and the transaction is initialized with
+// TransactionClient.InitializeContext, so the CallOption of ctx e.g. WithIdempotencyKey apply to it. amount
+// is in the subunit of currency e.g. kobo. callbackUrl is optional; when it is empty, the callback url
+// configured on your dashboard is used.
+//
+// Example
+//
+//	import (
+//		"context"
+//		"net/http"
+//		p "github.com/gray-adeyi/paystack"
+//	)
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	payment, err := p.CollectPayment(context.TODO(), client, "johndoe@example.com", 500000, "NGN",
+//		"https://example.com/payments/callback")
+//	if err != nil {
+//		panic(err)
+//	}
+//	// store payment.Reference then
+//	http.Redirect(w, r, payment.AuthorizationUrl, http.StatusSeeOther)
+func CollectPayment(ctx context.Context, client *APIClient, email string, amount int, currency string, callbackUrl string) (CollectedPayment, error) {
+	if _, err := mail.ParseAddress(email); err != nil {
+		return CollectedPayment{}, fmt.Errorf("%w: email %q is not valid", ErrInvalidPayment, email)
+	}
+	if amount <= 0 {
+		return CollectedPayment{}, fmt.Errorf("%w: amount must be greater than 0", ErrInvalidPayment)
+	}
+	if !collectionCurrencies[currency] {
+		return CollectedPayment{}, fmt.Errorf("%w: currency %q is not supported", ErrInvalidPayment, currency)
+	}
+	if callbackUrl != "" {
+		parsedUrl, err := url.Parse(callbackUrl)
+		if err != nil || (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") || parsedUrl.Host == "" {
+			return CollectedPayment{}, fmt.Errorf("%w: callback url %q is not valid", ErrInvalidPayment, callbackUrl)
+		}
+	}
+	reference := GenerateReference()
+	optionalPayloadParameters := []OptionalPayloadParameter{
+		WithOptionalParameter("currency", currency),
+		WithOptionalParameter("reference", reference),
+		WithOptionalParameter("metadata", map[string]interface{}{
+			"sdk":         "github.com/gray-adeyi/paystack",
+			"sdk_version": Version,
+		}),
+	}
+	if callbackUrl != "" {
+		optionalPayloadParameters = append(optionalPayloadParameters, WithOptionalParameter("callback_url", callbackUrl))
+	}
+	resp, err := client.Transactions.InitializeContext(ctx, amount, email, optionalPayloadParameters...)
+	if err != nil {
+		return CollectedPayment{}, err
+	}
+	var transaction struct {
+		Status  bool   `json:"status"`
+		Message string `json:"message"`
+		Data    struct {
+			AuthorizationUrl string `json:"authorization_url"`
+			AccessCode       string `json:"access_code"`
+			Reference        string `json:"reference"`
+		} `json:"data"`
+	}
+	if err = resp.Decode(&transaction); err != nil {
+		return CollectedPayment{}, err
+	}
+	if !transaction.Status {
+		return CollectedPayment{}, fmt.Errorf("unable to initialize transaction: %s", transaction.Message)
+	}
+	return CollectedPayment{
+		AuthorizationUrl: transaction.Data.AuthorizationUrl,
+		AccessCode:       transaction.Data.AccessCode,
+		Reference:        transaction.Data.Reference,
+	}, nil
+}
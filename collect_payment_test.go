@@ -0,0 +1,61 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCollectPaymentValidatesArguments(t *testing.T) {
+	client := NewAPIClient(WithSecretKey("sk_test"), WithDryRun())
+	cases := []struct {
+		email       string
+		amount      int
+		currency    string
+		callbackUrl string
+	}{
+		{email: "johndoe", amount: 500000, currency: "NGN"},
+		{email: "johndoe@example.com", amount: 0, currency: "NGN"},
+		{email: "johndoe@example.com", amount: 500000, currency: "NG"},
+		{email: "johndoe@example.com", amount: 500000, currency: "NGN", callbackUrl: "example.com/callback"},
+	}
+	for _, c := range cases {
+		_, err := CollectPayment(context.Background(), client, c.email, c.amount, c.currency, c.callbackUrl)
+		if !errors.Is(err, ErrInvalidPayment) {
+			t.Errorf("%+v: expected %v, got %v", c, ErrInvalidPayment, err)
+		}
+	}
+}
+
+func TestCollectPaymentUsesContext(t *testing.T) {
+	var requests int32
+	var key string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		key = r.Header.Get(IdempotencyKeyHeader)
+		w.Write([]byte(`{"status":true,"data":{"authorization_url":"https://checkout.paystack.com/x","access_code":"x","reference":"ref"}}`))
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	ctx := WithCallOptions(context.Background(), WithIdempotencyKey("order-1"))
+	payment, err := CollectPayment(ctx, client, "johndoe@example.com", 500000, "NGN", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payment.AuthorizationUrl != "https://checkout.paystack.com/x" || key != "order-1" {
+		t.Fatalf("expected the call options of the context to be applied, got %+v %q", payment, key)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = CollectPayment(ctx, client, "johndoe@example.com", 500000, "NGN", ""); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected %v, got %v", context.Canceled, err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Fatalf("expected a canceled caller not to initialize a transaction, got %d requests", got)
+	}
+}
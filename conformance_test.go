@@ -0,0 +1,173 @@
+package paystack
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// The conformance suite exercises the major flows against paystack's test mode. It only runs when
+// PAYSTACK_TEST_SECRET_KEY is set to a test secret key:
+//
+//	PAYSTACK_TEST_SECRET_KEY=sk_test_xxx go test -run TestConformance -v
+//
+// When PAYSTACK_CONFORMANCE_REPORT is set, the report of the suite is also written to the file it names.
+
+type conformanceResult struct {
+	flow     string
+	step     string
+	err      error
+	duration time.Duration
+}
+
+type conformanceReport struct {
+	results []conformanceResult
+}
+
+// check runs a step of a flow. The step fails if it returns an error or paystack responds with `status`
+// false. It returns the response so that later steps can use its data.
+func (c *conformanceReport) check(t *testing.T, flow string, step string, call func() (*Response, error)) *Response {
+	t.Helper()
+	start := time.Now()
+	resp, err := call()
+	if err == nil {
+		var body envelope
+		if body, err = resp.envelope(); err == nil && !body.Status {
+			err = fmt.Errorf("status code %d: %s", resp.StatusCode, body.Message)
+		}
+	}
+	c.results = append(c.results, conformanceResult{flow: flow, step: step, err: err, duration: time.Since(start)})
+	if err != nil {
+		t.Errorf("%s: %s: %v", flow, step, err)
+		return nil
+	}
+	return resp
+}
+
+func (c *conformanceReport) String() string {
+	var b strings.Builder
+	passed := 0
+	for _, result := range c.results {
+		status := "PASS"
+		if result.err != nil {
+			status = "FAIL"
+		} else {
+			passed++
+		}
+		fmt.Fprintf(&b, "%s\t%-14s %-28s %6dms", status, result.flow, result.step, result.duration.Milliseconds())
+		if result.err != nil {
+			fmt.Fprintf(&b, "\t%v", result.err)
+		}
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "%d/%d steps passed (SDK version %s)\n", passed, len(c.results), Version)
+	return b.String()
+}
+
+func TestConformance(t *testing.T) {
+	secretKey := os.Getenv("PAYSTACK_TEST_SECRET_KEY")
+	if secretKey == "" {
+		t.Skip("PAYSTACK_TEST_SECRET_KEY is not set")
+	}
+	if DomainFromSecretKey(secretKey) != DomainTest {
+		t.Fatal("the conformance suite must only be run with a test secret key")
+	}
+	client := NewAPIClient(WithSecretKey(secretKey))
+	report := &conformanceReport{}
+	defer func() {
+		t.Log("\n" + report.String())
+		if path := os.Getenv("PAYSTACK_CONFORMANCE_REPORT"); path != "" {
+			if err := os.WriteFile(path, []byte(report.String()), 0o644); err != nil {
+				t.Error(err)
+			}
+		}
+	}()
+	suffix := GenerateReference()[4:12]
+	email := fmt.Sprintf("conformance+%s@example.com", suffix)
+
+	t.Run("transactions", func(t *testing.T) {
+		reference := GenerateReference()
+		report.check(t, "transactions", "initialize", func() (*Response, error) {
+			return client.Transactions.Initialize(10000, email, WithOptionalParameter("reference", reference))
+		})
+		report.check(t, "transactions", "verify", func() (*Response, error) {
+			return client.Transactions.Verify(reference)
+		})
+		report.check(t, "transactions", "list", func() (*Response, error) {
+			return client.Transactions.All(WithQuery("perPage", "1"))
+		})
+	})
+
+	t.Run("customers", func(t *testing.T) {
+		resp := report.check(t, "customers", "create", func() (*Response, error) {
+			return client.Customers.Create(email, "Conformance", "Suite")
+		})
+		if resp == nil {
+			return
+		}
+		var customer struct {
+			Data struct {
+				CustomerCode string `json:"customer_code"`
+			} `json:"data"`
+		}
+		if err := resp.Decode(&customer); err != nil {
+			t.Fatal(err)
+		}
+		report.check(t, "customers", "fetch", func() (*Response, error) {
+			return client.Customers.FetchOne(customer.Data.CustomerCode)
+		})
+		report.check(t, "customers", "update", func() (*Response, error) {
+			return client.Customers.Update(customer.Data.CustomerCode, WithOptionalParameter("last_name", "Suite Updated"))
+		})
+	})
+
+	t.Run("plans", func(t *testing.T) {
+		resp := report.check(t, "plans", "create", func() (*Response, error) {
+			return client.Plans.Create("Conformance "+suffix, 50000, "monthly")
+		})
+		if resp == nil {
+			return
+		}
+		var plan struct {
+			Data struct {
+				PlanCode string `json:"plan_code"`
+			} `json:"data"`
+		}
+		if err := resp.Decode(&plan); err != nil {
+			t.Fatal(err)
+		}
+		report.check(t, "plans", "fetch", func() (*Response, error) {
+			return client.Plans.FetchOne(plan.Data.PlanCode)
+		})
+		report.check(t, "subscriptions", "list for plan", func() (*Response, error) {
+			return client.Subscriptions.All(WithQuery("plan", plan.Data.PlanCode))
+		})
+	})
+
+	t.Run("transfers", func(t *testing.T) {
+		resp := report.check(t, "transfers", "create recipient", func() (*Response, error) {
+			return client.TransferRecipients.Create(RecipientTypeNuban, "Conformance Suite", "0000000000", "058",
+				WithOptionalParameter("currency", "NGN"))
+		})
+		if resp == nil {
+			return
+		}
+		var recipient struct {
+			Data struct {
+				RecipientCode string `json:"recipient_code"`
+			} `json:"data"`
+		}
+		if err := resp.Decode(&recipient); err != nil {
+			t.Fatal(err)
+		}
+		report.check(t, "transfers", "initiate", func() (*Response, error) {
+			return client.Transfers.Initiate("balance", 10000, recipient.Data.RecipientCode,
+				WithOptionalParameter("reason", "conformance suite"))
+		})
+		report.check(t, "transfers", "list", func() (*Response, error) {
+			return client.Transfers.All(WithQuery("perPage", "1"))
+		})
+	})
+}
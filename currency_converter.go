@@ -0,0 +1,108 @@
+package paystack
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// CurrencyConverter is implemented by types that convert amounts between currencies with rates you
+// provide. It is used by the reporting helpers e.g. SumTransactions to normalize figures of multiple
+// currencies into one reporting currency. Amounts are in the subunit of their currency.
+type CurrencyConverter interface {
+	Convert(amount int64, from string, to string) (int64, error)
+}
+
+// StaticRates is a CurrencyConverter with fixed rates. Each rate is the value of a unit of a currency in
+// a common base currency e.g. StaticRates{"USD": 1, "NGN": 0.00065}.
+type StaticRates map[string]float64
+
+// Convert converts amount from one currency to another using the fixed rates, rounding to the nearest subunit
+func (s StaticRates) Convert(amount int64, from string, to string) (int64, error) {
+	if from == to {
+		return amount, nil
+	}
+	fromRate, ok := s[from]
+	if !ok || fromRate <= 0 {
+		return 0, fmt.Errorf("no exchange rate for %s", from)
+	}
+	toRate, ok := s[to]
+	if !ok || toRate <= 0 {
+		return 0, fmt.Errorf("no exchange rate for %s", to)
+	}
+	return int64(math.Round(float64(amount) * fromRate / toRate)), nil
+}
+
+// TransactionTotals is the total amount of transactions per currency and in a reporting currency. It is
+// returned by SumTransactions.
+type TransactionTotals struct {
+	ByCurrency map[string]int64
+	// Total is the amount of all the transactions in Currency. It is only computed when a CurrencyConverter
+	// is provided to SumTransactions.
+	Total    int64
+	Currency string
+	Count    int
+}
+
+// SumTransactions lets you compute the total amount of the successful transactions among transactions, e.g.
+// the `data` of the responses of TransactionClient.All, per currency. If converter is not nil, the total is
+// also normalized into reportingCurrency.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	rates := p.StaticRates{"USD": 1, "NGN": 0.00065, "GHS": 0.067}
+//	totals, err := p.SumTransactions(transactions, rates, "USD")
+//	if err != nil {
+//		panic(err)
+//	}
+//	fmt.Println(totals.ByCurrency["NGN"], totals.Total)
+func SumTransactions(transactions []json.RawMessage, converter CurrencyConverter, reportingCurrency string) (TransactionTotals, error) {
+	totals := TransactionTotals{ByCurrency: make(map[string]int64), Currency: reportingCurrency}
+	for _, transaction := range transactions {
+		var t struct {
+			Status   string `json:"status"`
+			Amount   int64  `json:"amount"`
+			Currency string `json:"currency"`
+		}
+		if err := json.Unmarshal(transaction, &t); err != nil {
+			return totals, err
+		}
+		if t.Status != "success" {
+			continue
+		}
+		totals.ByCurrency[t.Currency] += t.Amount
+		totals.Count++
+	}
+	if converter == nil {
+		return totals, nil
+	}
+	total, err := convertTotals(totals.ByCurrency, converter, reportingCurrency)
+	if err != nil {
+		return totals, err
+	}
+	totals.Total = total
+	return totals, nil
+}
+
+// TotalBalance lets you compute the total of the balances of a HealthSnapshot in reportingCurrency
+func (s HealthSnapshot) TotalBalance(converter CurrencyConverter, reportingCurrency string) (int64, error) {
+	balances := make(map[string]int64, len(s.Balances))
+	for currency, balance := range s.Balances {
+		balances[currency] = int64(balance)
+	}
+	return convertTotals(balances, converter, reportingCurrency)
+}
+
+func convertTotals(amounts map[string]int64, converter CurrencyConverter, reportingCurrency string) (int64, error) {
+	var total int64
+	for currency, amount := range amounts {
+		converted, err := converter.Convert(amount, currency, reportingCurrency)
+		if err != nil {
+			return 0, err
+		}
+		total += converted
+	}
+	return total, nil
+}
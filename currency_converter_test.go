@@ -0,0 +1,29 @@
+package paystack
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSumTransactions(t *testing.T) {
+	transactions := []json.RawMessage{
+		[]byte(`{"status":"success","amount":200000,"currency":"NGN"}`),
+		[]byte(`{"status":"success","amount":300000,"currency":"NGN"}`),
+		[]byte(`{"status":"failed","amount":900000,"currency":"NGN"}`),
+		[]byte(`{"status":"success","amount":1000,"currency":"USD"}`),
+	}
+	rates := StaticRates{"USD": 1, "NGN": 0.001}
+	totals, err := SumTransactions(transactions, rates, "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if totals.Count != 3 || totals.ByCurrency["NGN"] != 500000 || totals.ByCurrency["USD"] != 1000 {
+		t.Fatalf("unexpected totals %+v", totals)
+	}
+	if totals.Total != 1500 {
+		t.Fatalf("expected a total of 1500, got %d", totals.Total)
+	}
+	if _, err = SumTransactions(transactions, StaticRates{"USD": 1}, "USD"); err == nil {
+		t.Fatal("expected an error for a currency without a rate")
+	}
+}
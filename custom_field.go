@@ -0,0 +1,106 @@
+package paystack
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode"
+)
+
+// CustomFieldType is the type of input a CustomField is collected with
+type CustomFieldType = string
+
+const (
+	CustomFieldTypeText   CustomFieldType = "text"
+	CustomFieldTypeNumber CustomFieldType = "number"
+	CustomFieldTypeEmail  CustomFieldType = "email"
+	CustomFieldTypePhone  CustomFieldType = "phone"
+)
+
+// CustomField is an extra field collected from the customer on a payment page or shown on the
+// dashboard for a transaction. Custom fields are sent in the `custom_fields` of the `metadata` with
+// WithCustomFields.
+type CustomField struct {
+	DisplayName  string          `json:"display_name"`
+	VariableName string          `json:"variable_name"`
+	Required     bool            `json:"required,omitempty"`
+	Type         CustomFieldType `json:"type,omitempty"`
+	// Value is the value of the field. It is only relevant for custom fields of a transaction.
+	Value interface{} `json:"value,omitempty"`
+}
+
+// NewCustomField lets you create a text CustomField whose VariableName is derived from displayName
+// e.g. "Invoice ID" becomes "invoice_id".
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	field := p.NewCustomField("Invoice ID").AsRequired().OfType(p.CustomFieldTypeNumber)
+func NewCustomField(displayName string) CustomField {
+	return CustomField{
+		DisplayName:  displayName,
+		VariableName: customFieldVariableName(displayName),
+		Type:         CustomFieldTypeText,
+	}
+}
+
+// AsRequired returns a copy of the CustomField that must be provided by the customer
+func (c CustomField) AsRequired() CustomField {
+	c.Required = true
+	return c
+}
+
+// OfType returns a copy of the CustomField with the provided type
+func (c CustomField) OfType(fieldType CustomFieldType) CustomField {
+	c.Type = fieldType
+	return c
+}
+
+// WithValue returns a copy of the CustomField with the provided value
+func (c CustomField) WithValue(value interface{}) CustomField {
+	c.Value = value
+	return c
+}
+
+// WithCustomFields lets you add custom fields to the `custom_fields` of the `metadata` of a payload e.g.
+// when creating a payment page with PaymentPageClient.Create. Other keys of the `metadata`, whether
+// provided as a map or a JSON string with WithOptionalParameter, are preserved.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	resp, err := client.PaymentPages.Create("Buttercup Brunch", p.WithCustomFields(
+//		p.NewCustomField("Table Number").AsRequired().OfType(p.CustomFieldTypeNumber),
+//		p.NewCustomField("Dietary Restrictions"),
+//	))
+func WithCustomFields(fields ...CustomField) OptionalPayloadParameter {
+	return func(m map[string]interface{}) map[string]interface{} {
+		metadata := make(map[string]interface{})
+		switch value := m["metadata"].(type) {
+		case map[string]interface{}:
+			metadata = value
+		case string:
+			_ = json.Unmarshal([]byte(value), &metadata)
+		}
+		var customFields []interface{}
+		if existing, ok := metadata["custom_fields"].([]interface{}); ok {
+			customFields = existing
+		}
+		for _, field := range fields {
+			customFields = append(customFields, field)
+		}
+		metadata["custom_fields"] = customFields
+		m["metadata"] = metadata
+		return m
+	}
+}
+
+// customFieldVariableName converts the display name of a custom field to snake case
+func customFieldVariableName(displayName string) string {
+	words := strings.FieldsFunc(strings.ToLower(displayName), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, "_")
+}
@@ -0,0 +1,23 @@
+package paystack
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestWithCustomFields(t *testing.T) {
+	payload := map[string]interface{}{"metadata": `{"cart_id":398}`}
+	payload = WithCustomFields(NewCustomField("Invoice ID").AsRequired())(payload)
+	payload = WithCustomFields(NewCustomField("Table Number").OfType(CustomFieldTypeNumber))(payload)
+
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"metadata":{"cart_id":398,"custom_fields":[` +
+		`{"display_name":"Invoice ID","variable_name":"invoice_id","required":true,"type":"text"},` +
+		`{"display_name":"Table Number","variable_name":"table_number","type":"number"}]}}`
+	if string(encoded) != want {
+		t.Fatalf("expected %s, got %s", want, encoded)
+	}
+}
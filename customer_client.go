@@ -1,8 +1,11 @@
 package paystack
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 // CustomerClient interacts with endpoints related to paystack Customer resource
@@ -24,7 +27,9 @@ func NewCustomerClient(options ...ClientOptions) *CustomerClient {
 	return client.Customers
 }
 
-// Create lets you create a customer on your Integration
+// Create lets you create a customer on your Integration. A `phone` optional parameter in an
+// international format is normalized to the E.164 format with NormalizePhone and an obviously invalid
+// one is rejected before a request is made to paystack.
 //
 // Example:
 //
@@ -63,6 +68,11 @@ func NewCustomerClient(options ...ClientOptions) *CustomerClient {
 //	}
 //	fmt.Println(data)
 func (c *CustomerClient) Create(email string, firstName string, lastName string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error) {
+	return c.create(context.Background(), email, firstName, lastName, optionalPayloadParameters...)
+}
+
+// create creates a customer with ctx
+func (c *CustomerClient) create(ctx context.Context, email string, firstName string, lastName string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error) {
 	payload := make(map[string]interface{})
 	payload["email"] = email
 	payload["first_name"] = firstName
@@ -71,8 +81,16 @@ func (c *CustomerClient) Create(email string, firstName string, lastName string,
 	for _, optionalPayloadParameter := range optionalPayloadParameters {
 		payload = optionalPayloadParameter(payload)
 	}
+	// phone numbers in an international format are normalized, the country of other formats is unknown
+	if phone, ok := payload["phone"].(string); ok && strings.HasPrefix(strings.TrimSpace(phone), "+") {
+		normalizedPhone, err := NormalizePhone(phone, "")
+		if err != nil {
+			return nil, err
+		}
+		payload["phone"] = normalizedPhone
+	}
 
-	return c.APICall(http.MethodPost, "/customer", payload)
+	return c.apiCall(ctx, http.MethodPost, "/customer", payload)
 }
 
 // All lets you retrieve Customers available on your Integration.
@@ -196,6 +214,81 @@ func (c *CustomerClient) Update(code string, optionalPayloadParameters ...Option
 	return c.APICall(http.MethodPut, fmt.Sprintf("/customer/%s", code), payload)
 }
 
+// Upsert lets you create a customer or update the customer if one already exists with the provided email.
+// It tries to create the customer and if paystack responds that the customer already exists, the customer
+// is fetched by email and updated with the provided fields. The response of the final create or update
+// call is returned alongside a boolean which is true if the customer was created. ctx applies to each of
+// these calls, so an existing customer is left as it was if ctx is done before it is updated.
+//
+// Example:
+//
+//	import (
+//		"context"
+//		"fmt"
+//		p "github.com/gray-adeyi/paystack"
+//		"encoding/json"
+//	)
+//
+//	customerClient := p.NewCustomerClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// Alternatively, you can access a customer client from an APIClient
+//	// paystackClient := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// paystackClient.Customers field is a `CustomerClient`
+//	// Therefore, this is possible
+//	// resp, created, err := paystackClient.Customers.Upsert(ctx, "johndoe@example.com","John","Doe")
+//
+//	// you can pass in optional parameters to the `Customers.Upsert` with `p.WithOptionalParameter`
+//	// for example say you want to specify the `phone`.
+//	// resp, created, err := customerClient.Upsert(ctx, "johndoe@example.com","John","Doe",
+//	//	p.WithOptionalParameter("phone","+2348123456789"))
+//	resp, created, err := customerClient.Upsert(context.TODO(), "johndoe@example.com","John","Doe")
+//	if err != nil {
+//		panic(err)
+//	}
+//	// you can have data be a custom structure based on the data your interested in retrieving from
+//	// from paystack for simplicity, we're using `map[string]interface{}` which is sufficient to
+//	// to serialize the json data returned by paystack
+//	data := make(map[string]interface{})
+//
+//	err := json.Unmarshal(resp.Data, &data); if err != nil {
+//		panic(err)
+//	}
+//	fmt.Println(created, data)
+func (c *CustomerClient) Upsert(ctx context.Context, email string, firstName string, lastName string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, bool, error) {
+	resp, err := c.create(ctx, email, firstName, lastName, optionalPayloadParameters...)
+	created := responseOf(resp, err)
+	if created == nil {
+		return nil, false, err
+	}
+	if body, decodeErr := created.envelope(); decodeErr != nil || body.Status || !strings.Contains(strings.ToLower(body.Message), "already exist") {
+		return resp, resp != nil && resp.StatusCode < http.StatusBadRequest, err
+	}
+
+	resp, err = c.apiCall(ctx, http.MethodGet, fmt.Sprintf("/customer/%s", email), nil)
+	if err != nil {
+		return nil, false, err
+	}
+	body, err := resp.envelope()
+	if err != nil || !body.Status {
+		return resp, false, nil
+	}
+	var customer struct {
+		CustomerCode string `json:"customer_code"`
+	}
+	if err = json.Unmarshal(body.Data, &customer); err != nil {
+		return nil, false, err
+	}
+
+	payload := map[string]interface{}{"first_name": firstName, "last_name": lastName}
+	for _, optionalPayloadParameter := range optionalPayloadParameters {
+		payload = optionalPayloadParameter(payload)
+	}
+	resp, err = c.apiCall(ctx, http.MethodPut, fmt.Sprintf("/customer/%s", customer.CustomerCode), payload)
+	if err != nil {
+		return nil, false, err
+	}
+	return resp, false, nil
+}
+
 // Validate lets you validate a customer's identity
 //
 // Example:
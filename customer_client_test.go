@@ -0,0 +1,108 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newCustomerUpsertServer returns a server where the customer with existingEmail already exists, recording
+// the requests it received
+func newCustomerUpsertServer(t *testing.T, existingEmail string, requests *[]string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		request := r.Method + " " + r.URL.Path
+		if r.Method != http.MethodGet {
+			body, _ := json.Marshal(payload)
+			request += " " + string(body)
+		}
+		*requests = append(*requests, request)
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/customer":
+			if payload["email"] == existingEmail {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"status":false,"message":"Customer already exists"}`))
+				return
+			}
+			w.Write([]byte(`{"status":true,"message":"Customer created","data":{"customer_code":"CUS_new"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/customer/"+existingEmail:
+			w.Write([]byte(`{"status":true,"message":"Customer retrieved","data":{"customer_code":"CUS_1"}}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/customer/CUS_1":
+			w.Write([]byte(`{"status":true,"message":"Customer updated","data":{"customer_code":"CUS_1"}}`))
+		default:
+			t.Errorf("unexpected request %s", request)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCustomerUpsert(t *testing.T) {
+	tests := []struct {
+		name     string
+		email    string
+		created  bool
+		requests []string
+	}{
+		{
+			name:    "new customer",
+			email:   "jane@example.com",
+			created: true,
+			requests: []string{
+				`POST /customer {"email":"jane@example.com","first_name":"Jane","last_name":"Doe","phone":"+2348123456789"}`,
+			},
+		},
+		{
+			name:  "existing customer",
+			email: "john@example.com",
+			requests: []string{
+				`POST /customer {"email":"john@example.com","first_name":"Jane","last_name":"Doe","phone":"+2348123456789"}`,
+				`GET /customer/john@example.com`,
+				`PUT /customer/CUS_1 {"first_name":"Jane","last_name":"Doe","phone":"+2348123456789"}`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		for _, strict := range []bool{false, true} {
+			var requests []string
+			server := newCustomerUpsertServer(t, "john@example.com", &requests)
+			options := []ClientOptions{WithSecretKey("sk_test"), WithBaseUrl(server.URL)}
+			if strict {
+				options = append(options, WithStrictErrors())
+			}
+			client := NewAPIClient(options...)
+
+			resp, created, err := client.Customers.Upsert(context.Background(), tt.email, "Jane", "Doe",
+				WithOptionalParameter("phone", "+2348123456789"))
+			if err != nil {
+				t.Fatalf("%s, strict %v: %v", tt.name, strict, err)
+			}
+			if created != tt.created || resp == nil || resp.StatusCode != http.StatusOK {
+				t.Errorf("%s, strict %v: expected created %v, got %v %v", tt.name, strict, tt.created, created, resp)
+			}
+			if got, want := strings.Join(requests, "\n"), strings.Join(tt.requests, "\n"); got != want {
+				t.Errorf("%s, strict %v: expected requests\n%s\ngot\n%s", tt.name, strict, want, got)
+			}
+		}
+	}
+}
+
+func TestCustomerUpsertCanceled(t *testing.T) {
+	var requests []string
+	server := newCustomerUpsertServer(t, "john@example.com", &requests)
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, created, err := client.Customers.Upsert(ctx, "john@example.com", "Jane", "Doe"); !errors.Is(err, context.Canceled) || created {
+		t.Fatalf("expected a canceled upsert to fail, got %v %v", created, err)
+	}
+	if len(requests) != 0 {
+		t.Fatalf("expected no request to be made, got %v", requests)
+	}
+}
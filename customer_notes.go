@@ -0,0 +1,101 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// CustomerNotesMetadataKey is the key of the `metadata` of a customer the notes added with
+// CustomerClient.AppendNote are stored in
+const CustomerNotesMetadataKey = "sdk_notes"
+
+// CustomerNote is a note added to a customer with CustomerClient.AppendNote
+type CustomerNote struct {
+	Note      string    `json:"note"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AppendNote lets you add a timestamped note to a customer, so that your support agents can annotate
+// customers without a separate database. The notes are stored as an array in the `metadata` of the
+// customer under CustomerNotesMetadataKey. Existing notes and other keys of the `metadata` are preserved.
+// The notes of the customer including the new note are returned.
+//
+// Adding a note fetches the `metadata` of the customer and updates it, so the calls of a client are made one
+// at a time to keep them from overwriting each other's notes. An update of the `metadata` from elsewhere,
+// e.g. another process or the dashboard, made between the fetch and the update can still overwrite the
+// new note or be overwritten by it, so add the notes of a customer from a single client.
+//
+// Example:
+//
+//	import (
+//		"context"
+//		p "github.com/gray-adeyi/paystack"
+//	)
+//
+//	customerClient := p.NewCustomerClient(p.WithSecretKey("<paystack-secret-key>"))
+//	notes, err := customerClient.AppendNote(context.TODO(), "CUS_xnxdt6s1zg1f4nx", "Requested a refund over the phone")
+//	if err != nil {
+//		panic(err)
+//	}
+func (c *CustomerClient) AppendNote(ctx context.Context, code string, note string) ([]CustomerNote, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	select {
+	case c.notesLock <- struct{}{}:
+		defer func() { <-c.notesLock }()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	resp, err := c.apiCall(ctx, http.MethodGet, fmt.Sprintf("/customer/%s", code), nil)
+	if err != nil {
+		return nil, err
+	}
+	var customer struct {
+		Status  bool   `json:"status"`
+		Message string `json:"message"`
+		Data    struct {
+			Metadata map[string]json.RawMessage `json:"metadata"`
+		} `json:"data"`
+	}
+	if err = resp.Decode(&customer); err != nil {
+		return nil, err
+	}
+	if !customer.Status {
+		return nil, fmt.Errorf("unable to fetch customer %s: %s", code, customer.Message)
+	}
+
+	metadata := customer.Data.Metadata
+	if metadata == nil {
+		metadata = make(map[string]json.RawMessage)
+	}
+	var notes []CustomerNote
+	if existing, ok := metadata[CustomerNotesMetadataKey]; ok {
+		if err = json.Unmarshal(existing, &notes); err != nil {
+			return nil, fmt.Errorf("unable to read the notes of customer %s: %w", code, err)
+		}
+	}
+	notes = append(notes, CustomerNote{Note: note, CreatedAt: time.Now().UTC()})
+	encoded, err := json.Marshal(notes)
+	if err != nil {
+		return nil, err
+	}
+	metadata[CustomerNotesMetadataKey] = encoded
+
+	resp, err = c.apiCall(ctx, http.MethodPut, fmt.Sprintf("/customer/%s", code),
+		map[string]interface{}{"metadata": metadata})
+	if err != nil {
+		return nil, err
+	}
+	body, err := resp.envelope()
+	if err != nil {
+		return nil, err
+	}
+	if !body.Status {
+		return nil, fmt.Errorf("unable to update customer %s: %s", code, body.Message)
+	}
+	return notes, nil
+}
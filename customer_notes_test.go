@@ -0,0 +1,104 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// customerMetadataServer is a fake of the customer endpoints that keeps the metadata of a customer
+type customerMetadataServer struct {
+	mu       sync.Mutex
+	metadata map[string]json.RawMessage
+	requests int
+}
+
+func (f *customerMetadataServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests++
+	if r.URL.Path != "/customer/CUS_1" {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"status":false,"message":"Customer not found"}`))
+		return
+	}
+	if r.Method == http.MethodPut {
+		var body struct {
+			Metadata map[string]json.RawMessage `json:"metadata"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		f.metadata = body.Metadata
+	}
+	data, _ := json.Marshal(map[string]interface{}{"status": true, "data": map[string]interface{}{"metadata": f.metadata}})
+	w.Write(data)
+}
+
+func TestAppendNote(t *testing.T) {
+	fake := &customerMetadataServer{metadata: map[string]json.RawMessage{
+		"plan":                   json.RawMessage(`"gold"`),
+		CustomerNotesMetadataKey: json.RawMessage(`[{"note":"first","created_at":"2024-01-01T00:00:00Z"}]`),
+	}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	notes, err := client.Customers.AppendNote(context.Background(), "CUS_1", "second")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(notes) != 2 || notes[0].Note != "first" || notes[1].Note != "second" || notes[1].CreatedAt.IsZero() {
+		t.Fatalf("expected the new note after the existing one, got %+v", notes)
+	}
+	if string(fake.metadata["plan"]) != `"gold"` {
+		t.Fatalf("expected the other keys of the metadata to be preserved, got %s", fake.metadata)
+	}
+
+	if _, err = client.Customers.AppendNote(context.Background(), "CUS_unknown", "note"); err == nil {
+		t.Fatal("expected an unknown customer to be an error")
+	}
+}
+
+func TestAppendNoteConcurrently(t *testing.T) {
+	fake := &customerMetadataServer{}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if _, err := client.Customers.AppendNote(context.Background(), "CUS_1", strconv.Itoa(i)); err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	var notes []CustomerNote
+	json.Unmarshal(fake.metadata[CustomerNotesMetadataKey], &notes)
+	if len(notes) != 10 {
+		t.Fatalf("expected no note to be lost, got %d notes", len(notes))
+	}
+}
+
+func TestAppendNoteCanceled(t *testing.T) {
+	fake := &customerMetadataServer{}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := client.Customers.AppendNote(ctx, "CUS_1", "note"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected %v, got %v", context.Canceled, err)
+	}
+	if fake.requests != 0 {
+		t.Fatalf("expected no request to be made, got %d", fake.requests)
+	}
+}
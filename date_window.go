@@ -0,0 +1,163 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// dateWindowLayout is the format of the `from` and `to` queries sent for a DateWindow
+const dateWindowLayout = "2006-01-02T15:04:05.000Z"
+
+// ListFunc is the signature of the methods of the dedicated clients that list a resource e.g.
+// TransactionClient.All
+type ListFunc = func(queries ...Query) (*Response, error)
+
+// DateWindow is a half-open time range used to filter the resources retrieved by a ListFunc. It includes
+// From and excludes To, so that consecutive windows do not share the resources created at their boundary.
+type DateWindow struct {
+	From time.Time
+	To   time.Time
+}
+
+// Queries returns the `from` and `to` queries of a DateWindow. Since paystack includes the resources
+// created at `to`, the `to` query is a millisecond, the precision of the queries, before To.
+func (w DateWindow) Queries() []Query {
+	return DateRange(w.From, w.To.Truncate(time.Millisecond).Add(-time.Millisecond))
+}
+
+// DateRange lets you create the `from` and `to` queries that filter the resources retrieved by the methods
+// that list a resource e.g. TransactionClient.All, in the format paystack expects. The times are converted
+// to UTC. A zero from or to is left out, leaving the range open on that side.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	to := time.Now()
+//	from := to.AddDate(0, -1, 0)
+//	resp, err := client.Transactions.All(append(p.DateRange(from, to), p.WithQuery("status", "success"))...)
+func DateRange(from time.Time, to time.Time) []Query {
+	var queries []Query
+	if !from.IsZero() {
+		queries = append(queries, WithQuery("from", formatQueryTime(from)))
+	}
+	if !to.IsZero() {
+		queries = append(queries, WithQuery("to", formatQueryTime(to)))
+	}
+	return queries
+}
+
+// formatQueryTime formats t in the format paystack expects in the queries of a request
+func formatQueryTime(t time.Time) string {
+	return t.UTC().Format(dateWindowLayout)
+}
+
+// SplitDateWindow lets you split the time range between from and to into consecutive DateWindow of
+// size. The last DateWindow may be shorter than size. Like a DateWindow, the time range includes from and
+// excludes to.
+func SplitDateWindow(from time.Time, to time.Time, size time.Duration) []DateWindow {
+	var windows []DateWindow
+	if size <= 0 || !from.Before(to) {
+		return windows
+	}
+	for start := from; start.Before(to); start = start.Add(size) {
+		end := start.Add(size)
+		if end.After(to) {
+			end = to
+		}
+		windows = append(windows, DateWindow{From: start, To: end})
+	}
+	return windows
+}
+
+// DateWindowOptions configures how ListByDateWindow iterates over the windows of a time range
+type DateWindowOptions struct {
+	// ChunkSize is the size of each window. It defaults to a day.
+	ChunkSize time.Duration
+	// Concurrency is the maximum number of windows retrieved at the same time. It defaults to 1 which
+	// retrieves the windows sequentially.
+	Concurrency int
+	// Progress is called after each window is retrieved with the number of windows retrieved so far and
+	// the total number of windows.
+	Progress func(completed int, total int)
+}
+
+// ListByDateWindow lets you list a resource over a wide time range by splitting the time range into
+// smaller windows and listing all the pages of each window separately, since listing performance on
+// paystack degrades on very wide time ranges. The time range includes from and excludes to. The pages of
+// each window are returned in the order of their windows, i.e. responses[i] are the pages of the i-th
+// DateWindow of SplitDateWindow. queries are sent along with the `from`, `to` and pagination queries of
+// every page.
+//
+// If listing a page of any window fails, no new window is started and the error is returned once the
+// windows in progress are completed. If ctx is done before every window was listed, the pages of the
+// windows that were listed are returned alongside a *DeadlineExceededError whose Completed and Remaining
+// are the indexes of the windows. Since list does not take a context, ctx is checked before each page is
+// requested rather than interrupting a request in progress.
+//
+// Example
+//
+//	import (
+//		"time"
+//		p "github.com/gray-adeyi/paystack"
+//	)
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	to := time.Now()
+//	from := to.AddDate(-1, 0, 0)
+//	responses, err := p.ListByDateWindow(ctx, client.Transactions.All, from, to, p.DateWindowOptions{
+//		ChunkSize:   7 * 24 * time.Hour,
+//		Concurrency: 2,
+//		Progress: func(completed int, total int) {
+//			fmt.Printf("retrieved %d of %d weeks\n", completed, total)
+//		},
+//	}, p.WithQuery("status", "success"))
+func ListByDateWindow(ctx context.Context, list ListFunc, from time.Time, to time.Time, options DateWindowOptions, queries ...Query) ([][]*Response, error) {
+	if options.ChunkSize <= 0 {
+		options.ChunkSize = 24 * time.Hour
+	}
+	if options.Concurrency <= 0 {
+		options.Concurrency = 1
+	}
+	windows := SplitDateWindow(from, to, options.ChunkSize)
+	if len(windows) == 0 {
+		return nil, errors.New("from must be before to")
+	}
+	listPage := func(queries ...Query) (*Response, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return list(queries...)
+	}
+
+	responses := make([][]*Response, len(windows))
+	var mu sync.Mutex
+	completed := 0
+	_, err := parallel(ctx, len(windows), ParallelOptions{Workers: options.Concurrency},
+		func(ctx context.Context, i int) error {
+			var pages []*Response
+			err := forEachPage(listPage, func(resp *Response) (bool, error) {
+				pages = append(pages, resp)
+				return true, nil
+			}, append(windows[i].Queries(), queries...)...)
+			if err != nil {
+				return err
+			}
+			responses[i] = pages
+			mu.Lock()
+			defer mu.Unlock()
+			completed++
+			if options.Progress != nil {
+				options.Progress(completed, len(windows))
+			}
+			return nil
+		})
+	var deadlineErr *DeadlineExceededError
+	if err != nil && !errors.As(err, &deadlineErr) {
+		return nil, err
+	}
+	return responses, err
+}
@@ -0,0 +1,159 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDateRange(t *testing.T) {
+	lagos := time.FixedZone("WAT", 3600)
+	from := time.Date(2024, 1, 1, 1, 0, 0, 0, lagos)
+	to := time.Date(2024, 1, 31, 23, 59, 59, 500*int(time.Millisecond), time.UTC)
+
+	expected := []Query{WithQuery("from", "2024-01-01T00:00:00.000Z"), WithQuery("to", "2024-01-31T23:59:59.500Z")}
+	if got := DateRange(from, to); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	if got := DateRange(from, time.Time{}); !reflect.DeepEqual(got, expected[:1]) {
+		t.Errorf("expected an open ended range, got %v", got)
+	}
+}
+
+func TestSplitDateWindow(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	windows := SplitDateWindow(from, from.Add(60*time.Hour), 24*time.Hour)
+	expected := []DateWindow{
+		{From: from, To: from.Add(24 * time.Hour)},
+		{From: from.Add(24 * time.Hour), To: from.Add(48 * time.Hour)},
+		{From: from.Add(48 * time.Hour), To: from.Add(60 * time.Hour)},
+	}
+	if !reflect.DeepEqual(windows, expected) {
+		t.Fatalf("expected %v, got %v", expected, windows)
+	}
+	if windows := SplitDateWindow(from, from, time.Hour); len(windows) != 0 {
+		t.Errorf("expected no windows for an empty range, got %v", windows)
+	}
+	if windows := SplitDateWindow(from, from.Add(time.Hour), 0); len(windows) != 0 {
+		t.Errorf("expected no windows for a zero size, got %v", windows)
+	}
+}
+
+func TestDateWindowQueriesAreHalfOpen(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	windows := SplitDateWindow(from, from.Add(48*time.Hour), 24*time.Hour)
+	expected := [][]Query{
+		{WithQuery("from", "2024-01-01T00:00:00.000Z"), WithQuery("to", "2024-01-01T23:59:59.999Z")},
+		{WithQuery("from", "2024-01-02T00:00:00.000Z"), WithQuery("to", "2024-01-02T23:59:59.999Z")},
+	}
+	for i, window := range windows {
+		if got := window.Queries(); !reflect.DeepEqual(got, expected[i]) {
+			t.Errorf("window %d: expected %v, got %v", i, expected[i], got)
+		}
+	}
+}
+
+// dateWindowList returns a ListFunc that serves pageCount pages for every window, recording the `from`
+// and `page` queries of the requests
+func dateWindowList(pageCount int, requests *[]string, mu *sync.Mutex) ListFunc {
+	return func(queries ...Query) (*Response, error) {
+		values := make(map[string]string)
+		for _, query := range queries {
+			values[query.Key] = query.Value
+		}
+		mu.Lock()
+		*requests = append(*requests, values["from"][:10]+"/"+values["page"])
+		mu.Unlock()
+		body := fmt.Sprintf(`{"status":true,"data":[{"from":%q,"page":%s}],"meta":{"page":%s,"pageCount":%d}}`,
+			values["from"], values["page"], values["page"], pageCount)
+		return &Response{StatusCode: http.StatusOK, Data: []byte(body)}, nil
+	}
+}
+
+func TestListByDateWindow(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var requests []string
+	var mu sync.Mutex
+	var progress []string
+	responses, err := ListByDateWindow(context.Background(), dateWindowList(2, &requests, &mu), from,
+		from.Add(72*time.Hour), DateWindowOptions{
+			Concurrency: 2,
+			Progress: func(completed int, total int) {
+				progress = append(progress, fmt.Sprintf("%d/%d", completed, total))
+			},
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(responses) != 3 {
+		t.Fatalf("expected the pages of 3 windows, got %d", len(responses))
+	}
+	for i, pages := range responses {
+		if len(pages) != 2 {
+			t.Fatalf("window %d: expected every page to be listed, got %d pages", i, len(pages))
+		}
+		var page struct {
+			Data []struct {
+				From string `json:"from"`
+			} `json:"data"`
+		}
+		pages[1].Decode(&page)
+		if want := formatQueryTime(from.Add(time.Duration(i) * 24 * time.Hour)); page.Data[0].From != want {
+			t.Errorf("window %d: expected the pages in the order of the windows, got %s", i, page.Data[0].From)
+		}
+	}
+	if len(requests) != 6 {
+		t.Errorf("expected 6 requests, got %v", requests)
+	}
+	if strings.Join(progress, ",") != "1/3,2/3,3/3" {
+		t.Errorf("expected progress for every window, got %v", progress)
+	}
+}
+
+func TestListByDateWindowFailedPage(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	list := func(queries ...Query) (*Response, error) {
+		for _, query := range queries {
+			if query.Key == "page" && query.Value == "2" {
+				return &Response{StatusCode: http.StatusInternalServerError, Data: []byte(`{"status":false}`)}, nil
+			}
+		}
+		return &Response{StatusCode: http.StatusOK, Data: []byte(`{"status":true,"data":[],"meta":{"pageCount":2}}`)}, nil
+	}
+	responses, err := ListByDateWindow(context.Background(), list, from, from.Add(48*time.Hour), DateWindowOptions{})
+	if !errors.Is(err, ErrServer) || responses != nil {
+		t.Fatalf("expected the failed page to be an error, got %v %v", responses, err)
+	}
+}
+
+func TestListByDateWindowReturnsPartialResults(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var requests []string
+	var mu sync.Mutex
+	list := dateWindowList(1, &requests, &mu)
+	responses, err := ListByDateWindow(ctx, list, from, from.Add(72*time.Hour), DateWindowOptions{
+		Progress: func(completed int, total int) {
+			if completed == 1 {
+				cancel()
+			}
+		},
+	})
+	var deadlineErr *DeadlineExceededError
+	if !errors.As(err, &deadlineErr) || !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected a *DeadlineExceededError, got %v", err)
+	}
+	if !reflect.DeepEqual(deadlineErr.Completed, []int{0}) || !reflect.DeepEqual(deadlineErr.Remaining, []int{1, 2}) {
+		t.Fatalf("expected the first window to be completed, got %+v", deadlineErr)
+	}
+	if len(responses) != 3 || len(responses[0]) != 1 || responses[1] != nil {
+		t.Fatalf("expected the pages of the completed window, got %v", responses)
+	}
+}
@@ -0,0 +1,118 @@
+package paystack
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// decodeErrorExcerptSize is the maximum number of bytes of a response body included in a DecodeError
+const decodeErrorExcerptSize = 200
+
+// ErrNilResponse is returned when decoding a nil *Response
+var ErrNilResponse = errors.New("unable to decode a nil response")
+
+// DecodeError is returned by Response.Decode when the data retrieved from paystack could not be
+// deserialized. It provides the context needed to figure out why the data could not be deserialized
+// and keeps the raw data accessible.
+type DecodeError struct {
+	// Endpoint is the method and path of the request e.g. `GET /transaction/verify/<reference>`
+	Endpoint string
+	// Path is the JSON path of the offending field e.g. `data.amount`. It is empty when the data is not
+	// valid JSON.
+	Path string
+	// Offset is the position in Raw where the error occurred
+	Offset int64
+	// Excerpt is a truncated portion of Raw around Offset
+	Excerpt string
+	// Raw is the data retrieved from paystack
+	Raw []byte
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	message := fmt.Sprintf("unable to decode response of %s at offset %d", e.Endpoint, e.Offset)
+	if e.Path != "" {
+		message += fmt.Sprintf(" (field %s)", e.Path)
+	}
+	return fmt.Sprintf("%s: %v: %s", message, e.Err, e.Excerpt)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// Decode lets you deserialize Response.Data into v. If Response.Data could not be deserialized, a
+// *DecodeError is returned. A nil v is supported, in which case decoding is skipped and Response.Data
+// is left as is.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	resp, err := client.Transactions.Verify("<reference>")
+//	if err != nil {
+//		panic(err)
+//	}
+//	var transaction struct {
+//		Data struct {
+//			Amount int    `json:"amount"`
+//			Status string `json:"status"`
+//		} `json:"data"`
+//	}
+//	if err = resp.Decode(&transaction); err != nil {
+//		panic(err)
+//	}
+func (r *Response) Decode(v interface{}) error {
+	if r == nil {
+		return ErrNilResponse
+	}
+	if v == nil {
+		return nil
+	}
+	err := json.Unmarshal(r.Data, v)
+	if err == nil {
+		return nil
+	}
+	decodeErr := &DecodeError{
+		Endpoint: r.endpoint,
+		Raw:      r.Data,
+		Err:      err,
+	}
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		decodeErr.Offset = syntaxErr.Offset
+	case errors.As(err, &typeErr):
+		decodeErr.Offset = typeErr.Offset
+		decodeErr.Path = typeErr.Field
+	}
+	decodeErr.Excerpt = excerpt(r.Data, decodeErr.Offset)
+	return decodeErr
+}
+
+// excerpt returns a portion of data of at most decodeErrorExcerptSize bytes around offset
+func excerpt(data []byte, offset int64) string {
+	if len(data) <= decodeErrorExcerptSize {
+		return string(data)
+	}
+	start := int(offset) - decodeErrorExcerptSize/2
+	if start < 0 {
+		start = 0
+	}
+	end := start + decodeErrorExcerptSize
+	if end > len(data) {
+		end = len(data)
+		start = end - decodeErrorExcerptSize
+	}
+	result := string(data[start:end])
+	if start > 0 {
+		result = "..." + result
+	}
+	if end < len(data) {
+		result += "..."
+	}
+	return result
+}
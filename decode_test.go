@@ -0,0 +1,66 @@
+package paystack
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDecodeNilTarget(t *testing.T) {
+	r := &Response{StatusCode: 200, Data: []byte(`not json`)}
+	if err := r.Decode(nil); err != nil {
+		t.Fatalf("expected decoding into a nil target to be skipped, got %v", err)
+	}
+	if string(r.Data) != "not json" {
+		t.Fatalf("expected raw data to be retained, got %q", r.Data)
+	}
+
+	var nilResponse *Response
+	if err := nilResponse.Decode(&struct{}{}); !errors.Is(err, ErrNilResponse) {
+		t.Fatalf("expected %v, got %v", ErrNilResponse, err)
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	r := &Response{Data: []byte(`{"status":true,"data":{"amount":"20000"}}`), endpoint: "GET /transaction/verify/ref"}
+	var transaction struct {
+		Data struct {
+			Amount int `json:"amount"`
+		} `json:"data"`
+	}
+	err := r.Decode(&transaction)
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("expected a *DecodeError, got %v", err)
+	}
+	if decodeErr.Path != "data.amount" || decodeErr.Endpoint != "GET /transaction/verify/ref" {
+		t.Fatalf("unexpected decode error %+v", decodeErr)
+	}
+}
+
+func FuzzResponseDecode(f *testing.F) {
+	f.Add([]byte(`{"status":true,"message":"ok","data":{"id":1}}`))
+	f.Add([]byte(`{"status":"true","data":[]}`))
+	f.Add([]byte(`<html>bad gateway</html>`))
+	f.Add([]byte{})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		r := &Response{Data: data}
+		var target map[string]interface{}
+		_ = r.Decode(&target)
+		_ = r.Decode(nil)
+		_, _ = r.envelope()
+	})
+}
+
+func FuzzWebhookProcess(f *testing.F) {
+	f.Add([]byte(`{"event":"charge.success","data":{"id":1}}`))
+	f.Add([]byte(`{"event":1,"data":"x"}`))
+	f.Add([]byte(`null`))
+	f.Fuzz(func(t *testing.T, payload []byte) {
+		handler := NewWebhookHandler("sk_test")
+		handler.On("charge.success", func(event WebhookEvent) error {
+			event.ResourceId()
+			return errors.New("failed")
+		})
+		_ = handler.Process(payload, signWebhookPayload("sk_test", payload))
+	})
+}
@@ -0,0 +1,56 @@
+package paystack
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DedicatedAccountProvider is the slug of a bank that provides dedicated virtual accounts, i.e. the
+// `preferred_bank` of DedicatedVirtualAccountClient.Assign
+type DedicatedAccountProvider = string
+
+const (
+	DedicatedAccountWemaBank      DedicatedAccountProvider = "wema-bank"
+	DedicatedAccountTitanPaystack DedicatedAccountProvider = "titan-paystack"
+	// DedicatedAccountTestBank is the provider of dedicated virtual accounts in test mode
+	DedicatedAccountTestBank DedicatedAccountProvider = "test-bank"
+)
+
+// ErrInvalidDedicatedAccountProvider is returned when dedicated virtual accounts are not available in a country
+// or from a provider in the country
+var ErrInvalidDedicatedAccountProvider = errors.New("dedicated virtual account provider is not supported in country")
+
+// dedicatedAccountProviders are the providers of dedicated virtual accounts per country. Dedicated virtual
+// accounts are only available in NGN today.
+var dedicatedAccountProviders = map[string][]DedicatedAccountProvider{
+	"NG": {DedicatedAccountWemaBank, DedicatedAccountTitanPaystack, DedicatedAccountTestBank},
+}
+
+// ValidateDedicatedAccountProvider lets you check that provider offers dedicated virtual accounts in country
+// e.g. `wema-bank` in NG. country and provider are compared without regard to case. An error wrapping
+// ErrInvalidDedicatedAccountProvider is returned for an invalid combination. The providers available to your
+// integration can be retrieved with DedicatedVirtualAccountClient.BankProviders.
+func ValidateDedicatedAccountProvider(country string, provider DedicatedAccountProvider) error {
+	country = strings.ToUpper(country)
+	providers, ok := dedicatedAccountProviders[country]
+	if !ok {
+		countries := make([]string, 0, len(dedicatedAccountProviders))
+		for supported := range dedicatedAccountProviders {
+			countries = append(countries, supported)
+		}
+		sort.Strings(countries)
+		return fmt.Errorf("%w %q: dedicated virtual accounts are only available in %s", ErrInvalidDedicatedAccountProvider,
+			country, strings.Join(countries, ", "))
+	}
+	for _, supported := range providers {
+		if strings.EqualFold(provider, supported) {
+			return nil
+		}
+	}
+	supported := append([]string(nil), providers...)
+	sort.Strings(supported)
+	return fmt.Errorf("%w %s: %q (supported providers: %s)", ErrInvalidDedicatedAccountProvider, country, provider,
+		strings.Join(supported, ", "))
+}
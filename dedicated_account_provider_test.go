@@ -0,0 +1,37 @@
+package paystack
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateDedicatedAccountProvider(t *testing.T) {
+	for _, c := range []struct {
+		country  string
+		provider DedicatedAccountProvider
+		valid    bool
+	}{
+		{"NG", DedicatedAccountWemaBank, true},
+		{"ng", "Titan-Paystack", true},
+		{"NG", DedicatedAccountTestBank, true},
+		{"NG", "access-bank", false},
+		{"GH", DedicatedAccountWemaBank, false},
+		{"", DedicatedAccountWemaBank, false},
+	} {
+		err := ValidateDedicatedAccountProvider(c.country, c.provider)
+		if c.valid && err != nil {
+			t.Errorf("%s %s: expected no error, got %v", c.country, c.provider, err)
+		}
+		if !c.valid && !errors.Is(err, ErrInvalidDedicatedAccountProvider) {
+			t.Errorf("%s %s: expected %v, got %v", c.country, c.provider, ErrInvalidDedicatedAccountProvider, err)
+		}
+	}
+}
+
+func TestAssignRejectsUnsupportedCountry(t *testing.T) {
+	client := NewDedicatedVirtualAccountClient(WithSecretKey("sk_test"), WithBaseUrl("http://127.0.0.1:0"))
+	_, err := client.Assign("janedoe@test.com", "Jane", "Doe", "+233551234987", DedicatedAccountWemaBank, "GH")
+	if !errors.Is(err, ErrInvalidDedicatedAccountProvider) {
+		t.Fatalf("expected %v, got %v", ErrInvalidDedicatedAccountProvider, err)
+	}
+}
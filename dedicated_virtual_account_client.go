@@ -74,6 +74,10 @@ func (d *DedicatedVirtualAccountClient) Create(customerIdOrCode string,
 }
 
 // Assign lets you can create a customer, validate the customer, and assign a DVA to the customer.
+// The phone is normalized to the E.164 format for the country with NormalizePhone and an obviously
+// invalid phone is rejected before a request is made to paystack. The preferredBank is validated against
+// country with ValidateDedicatedAccountProvider, since dedicated virtual accounts are only available in
+// some countries.
 //
 // Example:
 //
@@ -116,6 +120,13 @@ func (d *DedicatedVirtualAccountClient) Create(customerIdOrCode string,
 func (d *DedicatedVirtualAccountClient) Assign(email string, firstName string, lastName string,
 	phone string, preferredBank string, country string,
 	optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error) {
+	if err := ValidateDedicatedAccountProvider(country, preferredBank); err != nil {
+		return nil, err
+	}
+	phone, err := NormalizePhone(phone, country)
+	if err != nil {
+		return nil, err
+	}
 	payload := make(map[string]interface{})
 	payload["email"] = email
 	payload["first_name"] = firstName
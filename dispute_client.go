@@ -1,8 +1,12 @@
 package paystack
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
+	"time"
 )
 
 // DisputeClient interacts with endpoint related to paystack dispute resource that lets you
@@ -234,6 +238,54 @@ func (d *DisputeClient) AddEvidence(id string, customerEmail string,
 	return d.APICall(http.MethodPost, fmt.Sprintf("/dispute/%s/evidence", id), payload)
 }
 
+// AddEvidenceFromTemplate lets you provide evidence for a dispute using one of the dispute evidence templates
+// (DeliveryProof, ServiceProof or SubscriptionProof). The template renders the `service_details` of the
+// evidence and attaches the optional parameters relevant to it.
+//
+// Example:
+//
+//	import (
+//		"fmt"
+//		"time"
+//		p "github.com/gray-adeyi/paystack"
+//		"encoding/json"
+//	)
+//
+//	dClient := p.NewDisputeClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// Alternatively, you can access a dispute client from an APIClient
+//	// paystackClient := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// paystackClient.Disputes field is a `DisputeClient`
+//	// Therefore, this is possible
+//	// resp, err := paystackClient.Disputes.AddEvidenceFromTemplate("<id>", "johndoe@example.com",
+//	//	"John Doe", "5085072209", evidence)
+//
+//	evidence := p.DeliveryProof{
+//		ProductDescription: "1 pair of sneakers",
+//		DeliveryAddress:    "3a ladoke street ogbomoso",
+//		DeliveryDate:       time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC),
+//		Courier:            "GIG Logistics",
+//	}
+//	resp, err := dClient.AddEvidenceFromTemplate("<id>", "johndoe@example.com", "John Doe", "5085072209", evidence)
+//	if err != nil {
+//		panic(err)
+//	}
+//	// you can have data be a custom structure based on the data your interested in retrieving from
+//	// from paystack for simplicity, we're using `map[string]interface{}` which is sufficient to
+//	// to serialize the json data returned by paystack
+//	data := make(map[string]interface{})
+//
+//	err := json.Unmarshal(resp.Data, &data); if err != nil {
+//		panic(err)
+//	}
+//	fmt.Println(data)
+func (d *DisputeClient) AddEvidenceFromTemplate(id string, customerEmail string,
+	customerName string, customerPhone string, evidence DisputeEvidence,
+	optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error) {
+	optionalPayloadParameters = append(evidence.OptionalPayloadParameters(), optionalPayloadParameters...)
+	return d.AddEvidence(id, customerEmail, customerName, customerPhone, evidence.ServiceDetails(),
+		optionalPayloadParameters...)
+}
+
 // UploadURL lets you retrieve Disputes for a particular transaction
 //
 // Example:
@@ -367,3 +419,90 @@ func (d *DisputeClient) Export(queries ...Query) (*Response, error) {
 	url := AddQueryParamsToUrl("/dispute/export", queries...)
 	return d.APICall(http.MethodGet, url, nil)
 }
+
+// DueWithin lets you retrieve the disputes awaiting your feedback that are due within d, ordered by the
+// time left before they are due. Disputes that are already overdue are included with a negative
+// DisputeDeadline.Remaining, so they come first. All the pages of disputes awaiting merchant feedback are
+// retrieved, and queries can be used to narrow them down e.g. with `from` and `to`. An error is returned if
+// a page of disputes could not be retrieved or the due date of a dispute can not be determined, rather than
+// risk missing it.
+//
+// Example:
+//
+//	import (
+//		"context"
+//		"fmt"
+//		"time"
+//		p "github.com/gray-adeyi/paystack"
+//	)
+//
+//	dClient := p.NewDisputeClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// Alternatively, you can access a dispute client from an APIClient
+//	// paystackClient := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// paystackClient.Disputes field is a `DisputeClient`
+//	// Therefore, this is possible
+//	// deadlines, err := paystackClient.Disputes.DueWithin(context.TODO(), 48 * time.Hour)
+//
+//	deadlines, err := dClient.DueWithin(context.TODO(), 48 * time.Hour)
+//	if err != nil {
+//		panic(err)
+//	}
+//	for _, deadline := range deadlines {
+//		fmt.Printf("dispute %s is due in %s\n", deadline.Id, deadline.Remaining)
+//	}
+func (d *DisputeClient) DueWithin(ctx context.Context, within time.Duration, queries ...Query) ([]DisputeDeadline, error) {
+	now := time.Now()
+	var deadlines []DisputeDeadline
+	queries = append([]Query{WithQuery("status", "awaiting-merchant-feedback")}, queries...)
+	list := func(queries ...Query) (*Response, error) {
+		return d.apiCall(ctx, http.MethodGet, AddQueryParamsToUrl("/dispute", queries...), nil)
+	}
+	err := forEachPage(list, func(resp *Response) (bool, error) {
+		var disputes struct {
+			Data []json.RawMessage `json:"data"`
+		}
+		if err := resp.Decode(&disputes); err != nil {
+			return false, err
+		}
+		for _, data := range disputes.Data {
+			var dispute struct {
+				Id          json.Number `json:"id"`
+				Status      string      `json:"status"`
+				Currency    string      `json:"currency"`
+				Amount      int         `json:"refund_amount"`
+				DueAt       *time.Time  `json:"dueAt"`
+				Transaction struct {
+					Reference string `json:"reference"`
+				} `json:"transaction"`
+			}
+			if err := json.Unmarshal(data, &dispute); err != nil {
+				return false, fmt.Errorf("unable to decode a dispute: %w", err)
+			}
+			if dispute.DueAt == nil {
+				return false, fmt.Errorf("dispute %s has no due date", dispute.Id)
+			}
+			remaining := dispute.DueAt.Sub(now)
+			if remaining > within {
+				continue
+			}
+			deadlines = append(deadlines, DisputeDeadline{
+				Id:        dispute.Id.String(),
+				Status:    dispute.Status,
+				Currency:  dispute.Currency,
+				Amount:    dispute.Amount,
+				Reference: dispute.Transaction.Reference,
+				DueAt:     *dispute.DueAt,
+				Remaining: remaining,
+				Data:      data,
+			})
+		}
+		return true, nil
+	}, queries...)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(deadlines, func(i, j int) bool {
+		return deadlines[i].Remaining < deadlines[j].Remaining
+	})
+	return deadlines, nil
+}
@@ -0,0 +1,84 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDueWithin(t *testing.T) {
+	now := time.Now().UTC()
+	dispute := func(id string, dueAt time.Time) string {
+		return `{"id":` + id + `,"status":"awaiting-merchant-feedback","currency":"NGN","refund_amount":5000,
+			"dueAt":"` + dueAt.Format(time.RFC3339) + `","transaction":{"reference":"ref_` + id + `"}}`
+	}
+	newClient := func(disputes string) *APIClient {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("status") != "awaiting-merchant-feedback" {
+				t.Errorf("unexpected query %v", r.URL.Query())
+			}
+			w.Write([]byte(`{"status":true,"data":[` + disputes + `],"meta":{"page":1,"pageCount":1}}`))
+		}))
+		t.Cleanup(server.Close)
+		return NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+	}
+
+	client := newClient(strings.Join([]string{
+		dispute("1", now.Add(30*time.Hour)),
+		dispute("2", now.Add(-time.Hour)),
+		dispute("3", now.Add(100*time.Hour)),
+		dispute("4", now.Add(2*time.Hour)),
+	}, ","))
+	deadlines, err := client.Disputes.DueWithin(context.Background(), 48*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, deadline := range deadlines {
+		ids = append(ids, deadline.Id)
+	}
+	if strings.Join(ids, ",") != "2,4,1" {
+		t.Fatalf("expected the overdue dispute first and the distant one left out, got %v", ids)
+	}
+	if deadlines[0].Remaining >= 0 || deadlines[0].Reference != "ref_2" {
+		t.Errorf("expected the overdue dispute to have a negative remaining time, got %+v", deadlines[0])
+	}
+
+	for name, disputes := range map[string]string{
+		"no due date":  `{"id":5,"status":"awaiting-merchant-feedback"}`,
+		"invalid data": `{"id":6,"dueAt":"tomorrow"}`,
+	} {
+		if _, err = newClient(disputes).Disputes.DueWithin(context.Background(), 48*time.Hour); err == nil {
+			t.Errorf("%s: expected an error instead of the dispute being dropped", name)
+		}
+	}
+}
+
+func TestDueWithinFailedPage(t *testing.T) {
+	for status, want := range map[int]error{
+		http.StatusUnauthorized:        ErrUnauthorized,
+		http.StatusTooManyRequests:     ErrRateLimited,
+		http.StatusInternalServerError: ErrServer,
+	} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("page") == "1" {
+				dueAt := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+				w.Write([]byte(`{"status":true,"data":[{"id":1,"dueAt":"` + dueAt + `"}],"meta":{"page":1,"pageCount":2}}`))
+				return
+			}
+			w.WriteHeader(status)
+			w.Write([]byte(`{"status":false,"message":"` + http.StatusText(status) + `"}`))
+		}))
+		client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL),
+			WithRateLimitBehavior(RateLimitFailFast))
+		deadlines, err := client.Disputes.DueWithin(context.Background(), 48*time.Hour)
+		server.Close()
+		if !errors.Is(err, want) || deadlines != nil {
+			t.Errorf("%d: expected the failed page to be an error, got %v %v", status, deadlines, err)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package paystack
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// icsTimeLayout is the format of the timestamps in an iCalendar file
+const icsTimeLayout = "20060102T150405Z"
+
+// DisputeDeadline is a dispute awaiting your response and the time left before it is due. A dispute that
+// is not responded to before it is due is resolved in the customer's favour.
+type DisputeDeadline struct {
+	Id        string
+	Status    string
+	Currency  string
+	Amount    int
+	Reference string
+	DueAt     time.Time
+	Remaining time.Duration
+	// Data is the raw data of the dispute
+	Data json.RawMessage
+}
+
+// DisputeRemindersICS lets you create an iCalendar (.ics) file with a reminder for each DisputeDeadline.
+// Each reminder is scheduled before the DisputeDeadline is due, so it can be imported into a calendar
+// application to avoid missing a dispute deadline.
+//
+// Example
+//
+//	import (
+//		"context"
+//		"os"
+//		"time"
+//		p "github.com/gray-adeyi/paystack"
+//	)
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	deadlines, err := client.Disputes.DueWithin(context.TODO(), 72 * time.Hour)
+//	if err != nil {
+//		panic(err)
+//	}
+//	err = os.WriteFile("disputes.ics", []byte(p.DisputeRemindersICS(deadlines, 24*time.Hour)), 0644)
+func DisputeRemindersICS(deadlines []DisputeDeadline, before time.Duration) string {
+	var b strings.Builder
+	b.WriteString("BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//gray-adeyi//paystack//EN\r\n")
+	now := time.Now().UTC().Format(icsTimeLayout)
+	for _, deadline := range deadlines {
+		dueAt := deadline.DueAt.UTC()
+		b.WriteString("BEGIN:VEVENT\r\n")
+		fmt.Fprintf(&b, "UID:dispute-%s@paystack\r\n", icsText(deadline.Id))
+		fmt.Fprintf(&b, "DTSTAMP:%s\r\n", now)
+		fmt.Fprintf(&b, "DTSTART:%s\r\n", dueAt.Add(-before).Format(icsTimeLayout))
+		fmt.Fprintf(&b, "DTEND:%s\r\n", dueAt.Format(icsTimeLayout))
+		fmt.Fprintf(&b, "SUMMARY:%s\r\n", icsText("Respond to paystack dispute "+deadline.Id))
+		fmt.Fprintf(&b, "DESCRIPTION:%s\r\n", icsText(fmt.Sprintf("Dispute %s on transaction %s for %s %d is due at %s",
+			deadline.Id, deadline.Reference, deadline.Currency, deadline.Amount, dueAt.Format(time.RFC1123))))
+		b.WriteString("BEGIN:VALARM\r\nACTION:DISPLAY\r\nTRIGGER:PT0M\r\n")
+		fmt.Fprintf(&b, "DESCRIPTION:%s\r\n", icsText("Paystack dispute "+deadline.Id+" is due soon"))
+		b.WriteString("END:VALARM\r\nEND:VEVENT\r\n")
+	}
+	b.WriteString("END:VCALENDAR\r\n")
+	return b.String()
+}
+
+// icsTextEscaper escapes the characters of a TEXT value of an iCalendar file as specified by RFC 5545
+var icsTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// icsText escapes text for use as a TEXT value of an iCalendar file
+func icsText(text string) string {
+	return icsTextEscaper.Replace(text)
+}
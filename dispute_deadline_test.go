@@ -0,0 +1,30 @@
+package paystack
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDisputeRemindersICS(t *testing.T) {
+	dueAt := time.Date(2024, 7, 8, 12, 0, 0, 0, time.UTC)
+	ics := DisputeRemindersICS([]DisputeDeadline{{
+		Id:        "42",
+		Currency:  "NGN",
+		Amount:    5000,
+		Reference: `ref;1,a\b`,
+		DueAt:     dueAt,
+	}}, 24*time.Hour)
+
+	for _, line := range []string{
+		"UID:dispute-42@paystack",
+		"DTSTART:20240707T120000Z",
+		"DTEND:20240708T120000Z",
+		"SUMMARY:Respond to paystack dispute 42",
+		`DESCRIPTION:Dispute 42 on transaction ref\;1\,a\\b for NGN 5000 is due at Mon\, 08 Jul 2024 12:00:00 UTC`,
+	} {
+		if !strings.Contains(ics, line+"\r\n") {
+			t.Errorf("expected the line %q in\n%s", line, ics)
+		}
+	}
+}
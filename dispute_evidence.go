@@ -0,0 +1,116 @@
+package paystack
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// evidenceDateLayout is the date format paystack expects for the `delivery_date` of a dispute evidence
+const evidenceDateLayout = "2006-01-02"
+
+// DisputeEvidence is implemented by the dispute evidence templates. A DisputeEvidence renders the
+// `service_details` of an evidence and provides the optional parameters relevant to the kind of evidence
+// it represents. It is used with DisputeClient.AddEvidenceFromTemplate
+type DisputeEvidence interface {
+	// ServiceDetails renders the `service_details` of the evidence
+	ServiceDetails() string
+
+	// OptionalPayloadParameters returns the optional parameters that should be sent along with the evidence
+	OptionalPayloadParameters() []OptionalPayloadParameter
+}
+
+// DeliveryProof is a DisputeEvidence template for disputes on physical goods that were delivered to
+// the customer.
+type DeliveryProof struct {
+	ProductDescription string
+	DeliveryAddress    string
+	DeliveryDate       time.Time
+	Courier            string
+	TrackingNumber     string
+}
+
+// ServiceDetails renders the `service_details` of a DeliveryProof
+func (d DeliveryProof) ServiceDetails() string {
+	details := []string{fmt.Sprintf("Delivered %s", d.ProductDescription)}
+	if d.DeliveryAddress != "" {
+		details = append(details, fmt.Sprintf("to %s", d.DeliveryAddress))
+	}
+	if !d.DeliveryDate.IsZero() {
+		details = append(details, fmt.Sprintf("on %s", d.DeliveryDate.Format(evidenceDateLayout)))
+	}
+	if d.Courier != "" {
+		details = append(details, fmt.Sprintf("via %s", d.Courier))
+	}
+	if d.TrackingNumber != "" {
+		details = append(details, fmt.Sprintf("(tracking number: %s)", d.TrackingNumber))
+	}
+	return strings.Join(details, " ") + "."
+}
+
+// OptionalPayloadParameters returns the `delivery_address` and `delivery_date` of a DeliveryProof
+func (d DeliveryProof) OptionalPayloadParameters() []OptionalPayloadParameter {
+	var parameters []OptionalPayloadParameter
+	if d.DeliveryAddress != "" {
+		parameters = append(parameters, WithOptionalParameter("delivery_address", d.DeliveryAddress))
+	}
+	if !d.DeliveryDate.IsZero() {
+		parameters = append(parameters, WithOptionalParameter("delivery_date", d.DeliveryDate.Format(evidenceDateLayout)))
+	}
+	return parameters
+}
+
+// ServiceProof is a DisputeEvidence template for disputes on services that were rendered to the customer.
+type ServiceProof struct {
+	ServiceDescription string
+	ServiceDate        time.Time
+	Location           string
+}
+
+// ServiceDetails renders the `service_details` of a ServiceProof
+func (s ServiceProof) ServiceDetails() string {
+	details := []string{fmt.Sprintf("Rendered %s", s.ServiceDescription)}
+	if s.Location != "" {
+		details = append(details, fmt.Sprintf("at %s", s.Location))
+	}
+	if !s.ServiceDate.IsZero() {
+		details = append(details, fmt.Sprintf("on %s", s.ServiceDate.Format(evidenceDateLayout)))
+	}
+	return strings.Join(details, " ") + "."
+}
+
+// OptionalPayloadParameters returns the optional parameters of a ServiceProof. A ServiceProof has none.
+func (s ServiceProof) OptionalPayloadParameters() []OptionalPayloadParameter {
+	return nil
+}
+
+// SubscriptionProof is a DisputeEvidence template for disputes on recurring charges of a subscription
+// the customer signed up for.
+type SubscriptionProof struct {
+	PlanName        string
+	StartDate       time.Time
+	LastBillingDate time.Time
+	// CustomerConsent describes how the customer agreed to be billed e.g. "accepted the terms of service on signup"
+	CustomerConsent string
+}
+
+// ServiceDetails renders the `service_details` of a SubscriptionProof
+func (s SubscriptionProof) ServiceDetails() string {
+	details := []string{fmt.Sprintf("Customer subscribed to the %s plan", s.PlanName)}
+	if !s.StartDate.IsZero() {
+		details = append(details, fmt.Sprintf("on %s", s.StartDate.Format(evidenceDateLayout)))
+	}
+	if !s.LastBillingDate.IsZero() {
+		details = append(details, fmt.Sprintf("and was last billed on %s", s.LastBillingDate.Format(evidenceDateLayout)))
+	}
+	rendered := strings.Join(details, " ") + "."
+	if s.CustomerConsent != "" {
+		rendered += fmt.Sprintf(" The customer %s.", s.CustomerConsent)
+	}
+	return rendered
+}
+
+// OptionalPayloadParameters returns the optional parameters of a SubscriptionProof. A SubscriptionProof has none.
+func (s SubscriptionProof) OptionalPayloadParameters() []OptionalPayloadParameter {
+	return nil
+}
@@ -0,0 +1,141 @@
+package paystack
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDisputeEvidenceServiceDetails(t *testing.T) {
+	date := time.Date(2024, 3, 5, 14, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		evidence DisputeEvidence
+		want     string
+	}{
+		{
+			name: "delivery proof",
+			evidence: DeliveryProof{
+				ProductDescription: "a pair of sneakers",
+				DeliveryAddress:    "3a Ladoke Akintola St, Ikeja",
+				DeliveryDate:       date,
+				Courier:            "GIG Logistics",
+				TrackingNumber:     "GIG-123",
+			},
+			want: "Delivered a pair of sneakers to 3a Ladoke Akintola St, Ikeja on 2024-03-05 via GIG Logistics (tracking number: GIG-123).",
+		},
+		{
+			name:     "minimal delivery proof",
+			evidence: DeliveryProof{ProductDescription: "a pair of sneakers"},
+			want:     "Delivered a pair of sneakers.",
+		},
+		{
+			name:     "service proof",
+			evidence: ServiceProof{ServiceDescription: "a deep clean", ServiceDate: date, Location: "Lekki Phase 1"},
+			want:     "Rendered a deep clean at Lekki Phase 1 on 2024-03-05.",
+		},
+		{
+			name:     "minimal service proof",
+			evidence: ServiceProof{ServiceDescription: "a deep clean"},
+			want:     "Rendered a deep clean.",
+		},
+		{
+			name: "subscription proof",
+			evidence: SubscriptionProof{
+				PlanName:        "Pro",
+				StartDate:       date,
+				LastBillingDate: date.AddDate(0, 2, 0),
+				CustomerConsent: "accepted the terms of service on signup",
+			},
+			want: "Customer subscribed to the Pro plan on 2024-03-05 and was last billed on 2024-05-05. " +
+				"The customer accepted the terms of service on signup.",
+		},
+		{
+			name:     "minimal subscription proof",
+			evidence: SubscriptionProof{PlanName: "Pro"},
+			want:     "Customer subscribed to the Pro plan.",
+		},
+	}
+	for _, tt := range tests {
+		if got := tt.evidence.ServiceDetails(); got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestDisputeEvidenceOptionalPayloadParameters(t *testing.T) {
+	date := time.Date(2024, 3, 5, 23, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		evidence DisputeEvidence
+		want     map[string]interface{}
+	}{
+		{
+			name:     "delivery proof",
+			evidence: DeliveryProof{ProductDescription: "sneakers", DeliveryAddress: "Ikeja", DeliveryDate: date},
+			want:     map[string]interface{}{"delivery_address": "Ikeja", "delivery_date": "2024-03-05"},
+		},
+		{
+			name:     "delivery proof without a delivery",
+			evidence: DeliveryProof{ProductDescription: "sneakers"},
+			want:     map[string]interface{}{},
+		},
+		{
+			name:     "service proof",
+			evidence: ServiceProof{ServiceDescription: "a deep clean", ServiceDate: date, Location: "Lekki"},
+			want:     map[string]interface{}{},
+		},
+		{
+			name:     "subscription proof",
+			evidence: SubscriptionProof{PlanName: "Pro", StartDate: date},
+			want:     map[string]interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		payload := map[string]interface{}{}
+		for _, parameter := range tt.evidence.OptionalPayloadParameters() {
+			payload = parameter(payload)
+		}
+		if !reflect.DeepEqual(payload, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, payload)
+		}
+	}
+}
+
+func TestAddEvidenceFromTemplate(t *testing.T) {
+	var path string
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.Method + " " + r.URL.Path
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.Write([]byte(`{"status":true,"message":"Evidence created"}`))
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	evidence := DeliveryProof{
+		ProductDescription: "a pair of sneakers",
+		DeliveryAddress:    "Ikeja",
+		DeliveryDate:       time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC),
+	}
+	_, err := client.Disputes.AddEvidenceFromTemplate("624", "johndoe@example.com", "John Doe", "5085072209",
+		evidence, WithOptionalParameter("delivery_address", "3a Ladoke Akintola St, Ikeja"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"customer_email":  "johndoe@example.com",
+		"customer_name":   "John Doe",
+		"customer_phone":  "5085072209",
+		"service_details": "Delivered a pair of sneakers to Ikeja on 2024-03-05.",
+		// the optional parameters of the caller take precedence over those of the template
+		"delivery_address": "3a Ladoke Akintola St, Ikeja",
+		"delivery_date":    "2024-03-05",
+	}
+	if path != "POST /dispute/624/evidence" || !reflect.DeepEqual(payload, want) {
+		t.Fatalf("expected %v to POST /dispute/624/evidence, got %v to %s", want, payload, path)
+	}
+}
@@ -0,0 +1,157 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Kinds of the entries of the thread of a dispute
+const (
+	DisputeThreadMessage = "message"
+	DisputeThreadHistory = "history"
+)
+
+// Formats a dispute thread can be exported to with DisputeClient.ExportThread
+const (
+	DisputeThreadFormatText = "text"
+	DisputeThreadFormatHTML = "html"
+)
+
+// DisputeThreadEntry is a message or a status change in the thread of a dispute
+type DisputeThreadEntry struct {
+	// Kind is either DisputeThreadMessage or DisputeThreadHistory
+	Kind string
+	// Author is the sender of a message or who changed the status of the dispute
+	Author string
+	// Body is the body of a message or a description of the status change
+	Body string
+	// Status is the status the dispute changed to. It is empty for messages.
+	Status    string
+	CreatedAt time.Time
+}
+
+// Thread lets you retrieve the messages and status history of a dispute merged into a single thread
+// ordered from the oldest entry to the newest.
+//
+// Example:
+//
+//	import (
+//		"context"
+//		"fmt"
+//		p "github.com/gray-adeyi/paystack"
+//	)
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	entries, err := client.Disputes.Thread(context.TODO(), "<dispute-id>")
+//	if err != nil {
+//		panic(err)
+//	}
+//	for _, entry := range entries {
+//		fmt.Println(entry.CreatedAt, entry.Author, entry.Body)
+//	}
+func (d *DisputeClient) Thread(ctx context.Context, id string) ([]DisputeThreadEntry, error) {
+	resp, err := d.apiCall(ctx, http.MethodGet, fmt.Sprintf("/dispute/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	var dispute struct {
+		Status  bool   `json:"status"`
+		Message string `json:"message"`
+		Data    struct {
+			Messages []struct {
+				Sender    string    `json:"sender"`
+				Body      string    `json:"body"`
+				CreatedAt time.Time `json:"createdAt"`
+			} `json:"messages"`
+			History []struct {
+				Status    string    `json:"status"`
+				By        string    `json:"by"`
+				CreatedAt time.Time `json:"createdAt"`
+			} `json:"history"`
+		} `json:"data"`
+	}
+	if err = resp.Decode(&dispute); err != nil {
+		return nil, err
+	}
+	if !dispute.Status {
+		return nil, fmt.Errorf("unable to fetch dispute %s: %s", id, dispute.Message)
+	}
+
+	var entries []DisputeThreadEntry
+	for _, history := range dispute.Data.History {
+		entries = append(entries, DisputeThreadEntry{
+			Kind:      DisputeThreadHistory,
+			Author:    history.By,
+			Body:      fmt.Sprintf("status changed to %s", history.Status),
+			Status:    history.Status,
+			CreatedAt: history.CreatedAt,
+		})
+	}
+	for _, message := range dispute.Data.Messages {
+		entries = append(entries, DisputeThreadEntry{
+			Kind:      DisputeThreadMessage,
+			Author:    message.Sender,
+			Body:      message.Body,
+			CreatedAt: message.CreatedAt,
+		})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].CreatedAt.Before(entries[j].CreatedAt)
+	})
+	return entries, nil
+}
+
+// ExportThread lets you render the thread of a dispute retrieved with DisputeClient.Thread for attaching to
+// internal case management systems. format is either DisputeThreadFormatText or DisputeThreadFormatHTML.
+//
+// Example:
+//
+//	import (
+//		"context"
+//		"os"
+//		p "github.com/gray-adeyi/paystack"
+//	)
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	thread, err := client.Disputes.ExportThread(context.TODO(), "<dispute-id>", p.DisputeThreadFormatHTML)
+//	if err != nil {
+//		panic(err)
+//	}
+//	err = os.WriteFile("dispute.html", []byte(thread), 0o644)
+func (d *DisputeClient) ExportThread(ctx context.Context, id string, format string) (string, error) {
+	entries, err := d.Thread(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	return RenderDisputeThread(id, entries, format)
+}
+
+// RenderDisputeThread renders the entries of the thread of a dispute as text or HTML. It is used by
+// DisputeClient.ExportThread.
+func RenderDisputeThread(id string, entries []DisputeThreadEntry, format string) (string, error) {
+	var b strings.Builder
+	switch format {
+	case DisputeThreadFormatText:
+		fmt.Fprintf(&b, "Dispute %s\n", id)
+		for _, entry := range entries {
+			fmt.Fprintf(&b, "\n[%s] %s (%s)\n%s\n", entry.CreatedAt.UTC().Format(time.RFC3339), entry.Author, entry.Kind, entry.Body)
+		}
+	case DisputeThreadFormatHTML:
+		fmt.Fprintf(&b, "<article class=\"dispute-thread\">\n<h1>Dispute %s</h1>\n", html.EscapeString(id))
+		for _, entry := range entries {
+			fmt.Fprintf(&b, "<section class=\"%s\">\n<header><time datetime=\"%s\">%s</time> %s</header>\n<p>%s</p>\n</section>\n",
+				html.EscapeString(entry.Kind),
+				entry.CreatedAt.UTC().Format(time.RFC3339), entry.CreatedAt.UTC().Format(time.RFC1123),
+				html.EscapeString(entry.Author), html.EscapeString(entry.Body))
+		}
+		b.WriteString("</article>\n")
+	default:
+		return "", fmt.Errorf("unsupported dispute thread format %q", format)
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,92 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDisputeThread(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/dispute/42" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{"status":true,"data":{
+			"messages":[
+				{"sender":"customer@example.com","body":"I was charged twice","createdAt":"2024-01-01T10:00:00Z"},
+				{"sender":"merchant","body":"We are looking into it","createdAt":"2024-01-02T09:00:00Z"}],
+			"history":[
+				{"status":"resolved","by":"merchant","createdAt":"2024-01-03T12:00:00Z"},
+				{"status":"awaiting-merchant-feedback","by":"paystack","createdAt":"2024-01-01T10:00:00Z"}]}}`))
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	entries, err := client.Disputes.Thread(context.Background(), "42")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, entry := range entries {
+		got = append(got, entry.Kind+":"+entry.Author)
+	}
+	want := "history:paystack,message:customer@example.com,message:merchant,history:merchant"
+	if strings.Join(got, ",") != want {
+		t.Fatalf("expected the entries ordered from the oldest, with history first on a tie, got %v", got)
+	}
+	if entries[3].Status != "resolved" || entries[3].Body != "status changed to resolved" {
+		t.Errorf("unexpected status change %+v", entries[3])
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = client.Disputes.Thread(ctx, "42"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestRenderDisputeThread(t *testing.T) {
+	entries := []DisputeThreadEntry{
+		{Kind: DisputeThreadMessage, Author: "Jane <jane@example.com>", Body: `<script>alert("x")</script> & more`,
+			CreatedAt: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)},
+		{Kind: DisputeThreadHistory, Author: "merchant", Body: "status changed to resolved", Status: "resolved",
+			CreatedAt: time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC)},
+	}
+
+	text, err := RenderDisputeThread("42", entries, DisputeThreadFormatText)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "Dispute 42\n" +
+		"\n[2024-01-01T10:00:00Z] Jane <jane@example.com> (message)\n<script>alert(\"x\")</script> & more\n" +
+		"\n[2024-01-03T12:00:00Z] merchant (history)\nstatus changed to resolved\n"
+	if text != want {
+		t.Errorf("expected\n%s\ngot\n%s", want, text)
+	}
+
+	rendered, err := RenderDisputeThread("<42>", entries, DisputeThreadFormatHTML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, escaped := range []string{
+		"<h1>Dispute &lt;42&gt;</h1>",
+		"Jane &lt;jane@example.com&gt;</header>",
+		"<p>&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt; &amp; more</p>",
+		`<time datetime="2024-01-03T12:00:00Z">Wed, 03 Jan 2024 12:00:00 UTC</time> merchant`,
+	} {
+		if !strings.Contains(rendered, escaped) {
+			t.Errorf("expected the HTML to contain %q, got\n%s", escaped, rendered)
+		}
+	}
+	if strings.Contains(rendered, "<script>") {
+		t.Errorf("expected the body to be escaped, got\n%s", rendered)
+	}
+
+	if _, err = RenderDisputeThread("42", entries, "pdf"); err == nil {
+		t.Error("expected an unsupported format to be an error")
+	}
+}
@@ -0,0 +1,79 @@
+package paystack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Do lets you call any endpoint of paystack, e.g. a new or undocumented endpoint the APIClient has no method
+// for yet, with the same authentication, retries, timeouts and other behaviors of the client. path is the path
+// of the endpoint, which may carry query parameters added with AddQueryParamsToUrl. payload is serialized as the
+// JSON body of the request, it can be nil. optionalPayloadParameters are added to payload, which must then be
+// nil, a map or a value that serializes to a JSON object.
+//
+// The body of the response is deserialized into v unless v is nil. Unlike the other methods of the client, Do
+// returns an *APIError alongside the Response if paystack failed the request, regardless of WithStrictErrors,
+// since v would otherwise hold a failure the caller did not expect.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	var terminals struct {
+//		Data []struct {
+//			Id   int    `json:"id"`
+//			Name string `json:"name"`
+//		} `json:"data"`
+//	}
+//	_, err := client.Do(ctx, http.MethodGet, p.AddQueryParamsToUrl("/virtual_terminal", p.WithQuery("status", "active")),
+//		nil, &terminals)
+func (a *APIClient) Do(ctx context.Context, method string, path string, payload interface{}, v interface{}, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error) {
+	if len(optionalPayloadParameters) > 0 {
+		m, err := payloadMap(payload)
+		if err != nil {
+			return nil, err
+		}
+		for _, optionalPayloadParameter := range optionalPayloadParameters {
+			m = optionalPayloadParameter(m)
+		}
+		payload = m
+	}
+	resp, err := a.apiCall(ctx, method, path, payload)
+	if err != nil {
+		// with strict errors the Response of a failed request is only carried by its *APIError
+		return responseOf(resp, err), err
+	}
+	if apiErr := resp.AsError(); apiErr != nil {
+		return resp, apiErr
+	}
+	return resp, resp.Decode(v)
+}
+
+// payloadMap returns a copy of payload as a map that optional parameters can be added to
+func payloadMap(payload interface{}) (map[string]interface{}, error) {
+	switch payload := payload.(type) {
+	case nil:
+		return map[string]interface{}{}, nil
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(payload))
+		for key, value := range payload {
+			m[key] = value
+		}
+		return m, nil
+	}
+	body, err := encodePayload(payload)
+	if err != nil {
+		return nil, err
+	}
+	// numbers are decoded as json.Number so that large amounts and ids are sent back unchanged
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var m map[string]interface{}
+	if err = decoder.Decode(&m); err != nil || m == nil {
+		return nil, fmt.Errorf("optional parameters can not be added to a %T payload", payload)
+	}
+	return m, nil
+}
@@ -0,0 +1,93 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDo(t *testing.T) {
+	var body map[string]interface{}
+	var idempotencyKey string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		body = nil
+		json.Unmarshal(raw, &body)
+		idempotencyKey = r.Header.Get(IdempotencyKeyHeader)
+		if r.URL.Path == "/unknown" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status":false,"message":"Not found"}`))
+			return
+		}
+		w.Write([]byte(`{"status":true,"message":"Terminal created","data":{"id":42,"name":"` + r.URL.Query().Get("name") + `"}}`))
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test_key"), WithBaseUrl(server.URL))
+
+	var terminal struct {
+		Data struct {
+			Id   int    `json:"id"`
+			Name string `json:"name"`
+		} `json:"data"`
+	}
+	payload := struct {
+		Name string `json:"name"`
+	}{Name: "Front desk"}
+	_, err := client.Do(WithCallOptions(context.Background(), WithIdempotencyKey("terminal-1")), http.MethodPost,
+		AddQueryParamsToUrl("/virtual_terminal", WithQuery("name", "desk")), payload, &terminal,
+		WithOptionalParameter("metadata", "x"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if terminal.Data.Id != 42 || terminal.Data.Name != "desk" {
+		t.Errorf("unexpected response %+v", terminal)
+	}
+	if body["name"] != "Front desk" || body["metadata"] != "x" || len(body) != 2 {
+		t.Errorf("unexpected payload %v", body)
+	}
+	if idempotencyKey != "terminal-1" {
+		t.Errorf("got idempotency key %q, want terminal-1", idempotencyKey)
+	}
+
+	for _, strict := range []bool{false, true} {
+		options := []ClientOptions{WithSecretKey("sk_test_key"), WithBaseUrl(server.URL)}
+		if strict {
+			options = append(options, WithStrictErrors())
+		}
+		var apiErr *APIError
+		resp, err := NewAPIClient(options...).Do(context.Background(), http.MethodGet, "/unknown", nil, &terminal)
+		if !errors.Is(err, ErrNotFound) || !errors.As(err, &apiErr) || resp == nil || resp.StatusCode != http.StatusNotFound {
+			t.Errorf("strict %v: got %v %v, want the response and an *APIError wrapping %v", strict, resp, err, ErrNotFound)
+		}
+	}
+
+	if _, err = client.Do(context.Background(), http.MethodPost, "/virtual_terminal", []int{1}, nil,
+		WithOptionalParameter("name", "desk")); err == nil {
+		t.Error("expected optional parameters on a non object payload to fail")
+	}
+}
+
+func TestDoKeepsLargeNumbers(t *testing.T) {
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		w.Write([]byte(`{"status":true,"message":"Charge attempted"}`))
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test_key"), WithBaseUrl(server.URL))
+
+	payload := struct {
+		Amount int64 `json:"amount"`
+	}{Amount: 9007199254740993}
+	if _, err := client.Do(context.Background(), http.MethodPost, "/charge", payload, nil,
+		WithOptionalParameter("currency", "NGN")); err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"amount":9007199254740993,"currency":"NGN"}`; string(body) != want {
+		t.Fatalf("got payload %s, want %s", body, want)
+	}
+}
@@ -0,0 +1,48 @@
+package paystack
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// Domain is the environment a record of your Integration belongs to. Records created with a test secret
+// key belong to the test domain and records created with a live secret key belong to the live domain.
+type Domain = string
+
+const (
+	DomainTest Domain = "test"
+	DomainLive Domain = "live"
+)
+
+// ErrDomainMismatch is returned when a record of one Domain is about to be mixed with records of another
+// Domain, e.g. a test transaction synced into a store namespace of live transactions.
+var ErrDomainMismatch = errors.New("record belongs to a different domain")
+
+// DomainFromSecretKey returns the Domain of the records created with secretKey. An empty Domain is
+// returned if it could not be determined.
+func DomainFromSecretKey(secretKey string) Domain {
+	switch {
+	case strings.HasPrefix(secretKey, "sk_test_"):
+		return DomainTest
+	case strings.HasPrefix(secretKey, "sk_live_"):
+		return DomainLive
+	}
+	return ""
+}
+
+// RecordDomain returns the `domain` of a record as returned by paystack. An empty Domain is returned if
+// the record does not have a valid `domain`.
+func RecordDomain(record json.RawMessage) Domain {
+	var r struct {
+		Domain string `json:"domain"`
+	}
+	if err := json.Unmarshal(record, &r); err != nil {
+		return ""
+	}
+	switch r.Domain {
+	case DomainTest, DomainLive:
+		return r.Domain
+	}
+	return ""
+}
@@ -0,0 +1,30 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSyncerRefusesRecordsOfAnotherDomain(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status":true,"message":"ok","data":[{"id":1,"domain":"live","createdAt":"2024-01-02T00:00:00.000Z"}],`+
+			`"meta":{"total":1,"page":1,"pageCount":1}}`)
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(WithSecretKey("sk_test_xxx"), WithBaseUrl(server.URL))
+	store := NewMemoryCursorStore()
+	syncer := NewSyncer(client, store)
+	syncer.Handle(SyncResourceTransactions, func(resource SyncResource, record json.RawMessage) error {
+		t.Fatal("expected the live record not to be handled")
+		return nil
+	})
+	if err := syncer.SyncOnce(context.Background()); !errors.Is(err, ErrDomainMismatch) {
+		t.Fatalf("expected %v, got %v", ErrDomainMismatch, err)
+	}
+}
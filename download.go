@@ -0,0 +1,235 @@
+package paystack
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ErrChecksumMismatch is returned when the content downloaded from a signed url does not match the
+// checksum advertised by the storage backend serving it.
+var ErrChecksumMismatch = errors.New("downloaded content does not match the checksum provided by the server")
+
+// maxDownloadResumeAttempts is the number of times an interrupted download is resumed before giving up
+const maxDownloadResumeAttempts = 3
+
+// DownloadProgress is the progress of a download reported to the function registered with
+// WithDownloadProgress
+type DownloadProgress struct {
+	// Bytes is the number of bytes of the file downloaded so far, including the bytes skipped with
+	// WithDownloadOffset
+	Bytes int64
+	// Total is the size of the file. It is -1 if the storage backend did not advertise it.
+	Total int64
+	// Rows is the number of lines of the file downloaded by the call so far, which is the number of rows
+	// of a CSV export plus its header
+	Rows int64
+}
+
+// DownloadOption is a type used to modify the behaviour of DownloadTo
+type DownloadOption = func(options *downloadOptions)
+
+type downloadOptions struct {
+	progress func(progress DownloadProgress)
+	offset   int64
+}
+
+// WithDownloadProgress lets you register a function that is called with the progress of a download every
+// time a chunk of the file is written, e.g. to report the progress of an export of years of transactions.
+func WithDownloadProgress(progress func(progress DownloadProgress)) DownloadOption {
+	return func(options *downloadOptions) {
+		options.progress = progress
+	}
+}
+
+// WithDownloadOffset lets you resume a download that was interrupted after offset bytes of the file were
+// written, e.g. by a restart of your application, by appending the rest of the file to w. The rest of the
+// file is requested with a range request if the storage backend supports them, otherwise the first offset
+// bytes are downloaded and discarded. The checksum of a resumed download is not verified since the bytes
+// written before offset are not available to DownloadTo.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	file, err := os.OpenFile("transactions.csv", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+//	if err != nil {
+//		panic(err)
+//	}
+//	info, err := file.Stat()
+//	if err != nil {
+//		panic(err)
+//	}
+//	_, err = client.DownloadTo(context.TODO(), "<signed-url>", file, p.WithDownloadOffset(info.Size()))
+func WithDownloadOffset(offset int64) DownloadOption {
+	return func(options *downloadOptions) {
+		options.offset = offset
+	}
+}
+
+// DownloadTo lets you stream the content of a signed url into w without holding the whole content in memory.
+// Endpoints like Transactions.Export and Disputes.Export respond with a signed url (`data.path`) to a file
+// that may be very large, DownloadTo is intended for retrieving such files. The number of bytes written to
+// w is returned alongside an error if any.
+//
+// When the storage backend advertises an MD5 checksum of the file (`Content-MD5` or `x-goog-hash`), the
+// downloaded content is verified against it and ErrChecksumMismatch is returned if they differ. If the
+// download is interrupted and the storage backend supports range requests, the download is resumed from
+// where it stopped. The secret key of the client is not sent along with the request. Use
+// WithDownloadProgress to report the progress of the download and WithDownloadOffset to resume a download
+// interrupted in a previous call.
+//
+// Example:
+//
+//	import (
+//		"context"
+//		"os"
+//		p "github.com/gray-adeyi/paystack"
+//	)
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	file, err := os.Create("transactions.csv")
+//	if err != nil {
+//		panic(err)
+//	}
+//	defer file.Close()
+//	// the signed url is retrieved from the `data.path` of the response of `client.Transactions.Export()`
+//	_, err = client.DownloadTo(context.TODO(), "<signed-url>", file,
+//		p.WithDownloadProgress(func(progress p.DownloadProgress) {
+//			log.Printf("downloaded %d of %d bytes", progress.Bytes, progress.Total)
+//		}))
+//	if err != nil {
+//		panic(err)
+//	}
+func (a *baseAPIClient) DownloadTo(ctx context.Context, url string, w io.Writer, options ...DownloadOption) (int64, error) {
+	var opts downloadOptions
+	for _, option := range options {
+		option(&opts)
+	}
+	var expectedChecksum []byte
+	var etag string
+	resumable := false
+	started := false
+	hash := md5.New()
+	writer := &progressWriter{w: w, report: opts.progress, policy: a.panicPolicy, progress: DownloadProgress{Bytes: opts.offset, Total: -1}}
+	written := func() int64 { return writer.progress.Bytes - opts.offset }
+
+	for attempt := 0; ; attempt++ {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return written(), err
+		}
+		if position := writer.progress.Bytes; position > 0 {
+			request.Header.Set("Range", fmt.Sprintf("bytes=%d-", position))
+			if etag != "" {
+				request.Header.Set("If-Range", etag)
+			}
+		}
+		r, err := a.httpClient.Do(request)
+		if err != nil {
+			if resumable && attempt < maxDownloadResumeAttempts && ctx.Err() == nil {
+				continue
+			}
+			return written(), err
+		}
+
+		if !started {
+			switch {
+			case r.StatusCode == http.StatusOK:
+				if r.ContentLength >= 0 {
+					writer.progress.Total = r.ContentLength
+				}
+				// the storage backend ignored the range of a resumed download
+				if opts.offset > 0 {
+					if _, err = io.CopyN(io.Discard, r.Body, opts.offset); err != nil {
+						r.Body.Close()
+						return written(), fmt.Errorf("unable to resume download: %w", err)
+					}
+				}
+			case r.StatusCode == http.StatusPartialContent && opts.offset > 0:
+				if r.ContentLength >= 0 {
+					writer.progress.Total = opts.offset + r.ContentLength
+				}
+			default:
+				r.Body.Close()
+				return written(), fmt.Errorf("unable to download file: unexpected status code %d", r.StatusCode)
+			}
+			if opts.offset == 0 {
+				expectedChecksum = checksumFromHeader(r.Header)
+			}
+			etag = r.Header.Get("ETag")
+			resumable = r.Header.Get("Accept-Ranges") == "bytes" || r.StatusCode == http.StatusPartialContent
+			started = true
+		} else if r.StatusCode != http.StatusPartialContent {
+			r.Body.Close()
+			return written(), fmt.Errorf("unable to resume download: unexpected status code %d", r.StatusCode)
+		}
+
+		_, err = io.Copy(io.MultiWriter(writer, hash), r.Body)
+		r.Body.Close()
+		if err == nil {
+			break
+		}
+		if !resumable || attempt >= maxDownloadResumeAttempts || ctx.Err() != nil {
+			return written(), err
+		}
+	}
+
+	if expectedChecksum != nil && !bytes.Equal(expectedChecksum, hash.Sum(nil)) {
+		return written(), ErrChecksumMismatch
+	}
+	return written(), nil
+}
+
+// progressWriter counts the bytes and lines written to w and reports them to report
+type progressWriter struct {
+	w        io.Writer
+	progress DownloadProgress
+	report   func(progress DownloadProgress)
+	policy   PanicPolicy
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.progress.Bytes += int64(n)
+	p.progress.Rows += int64(bytes.Count(b[:n], []byte("\n")))
+	if p.report != nil && n > 0 {
+		reportErr := callSafely("download progress callback", p.policy, func() error {
+			p.report(p.progress)
+			return nil
+		})
+		if err == nil {
+			err = reportErr
+		}
+	}
+	return n, err
+}
+
+// checksumFromHeader retrieves the MD5 checksum advertised by a storage backend. nil is returned if the
+// storage backend did not advertise one.
+func checksumFromHeader(header http.Header) []byte {
+	if contentMD5 := header.Get("Content-MD5"); contentMD5 != "" {
+		if checksum, err := base64.StdEncoding.DecodeString(contentMD5); err == nil {
+			return checksum
+		}
+	}
+	// google cloud storage sends the checksums as a comma separated list e.g. crc32c=n03x6A==,md5=Ojk9c3dhfxgoKVVHYwFbHQ==
+	for _, value := range header.Values("x-goog-hash") {
+		for _, part := range strings.Split(value, ",") {
+			algorithm, encoded, found := strings.Cut(strings.TrimSpace(part), "=")
+			if !found || algorithm != "md5" {
+				continue
+			}
+			if checksum, err := base64.StdEncoding.DecodeString(encoded); err == nil {
+				return checksum
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package paystack
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDownloadTo(t *testing.T) {
+	content := []byte("id,reference,amount\n1,ref_1,20000\n")
+	checksum := md5.Sum(content)
+	tests := []struct {
+		name        string
+		contentMD5  string
+		expectedErr error
+	}{
+		{"without checksum", "", nil},
+		{"with matching checksum", base64.StdEncoding.EncodeToString(checksum[:]), nil},
+		{"with mismatched checksum", base64.StdEncoding.EncodeToString(make([]byte, md5.Size)), ErrChecksumMismatch},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") != "" {
+					t.Error("secret key should not be sent to the storage backend")
+				}
+				if test.contentMD5 != "" {
+					w.Header().Set("Content-MD5", test.contentMD5)
+				}
+				w.Write(content)
+			}))
+			defer server.Close()
+
+			client := NewAPIClient(WithSecretKey("sk_test"))
+			var buf bytes.Buffer
+			n, err := client.DownloadTo(context.Background(), server.URL, &buf)
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+			if n != int64(len(content)) || !bytes.Equal(buf.Bytes(), content) {
+				t.Fatalf("expected %q to be downloaded, got %q", content, buf.Bytes())
+			}
+		})
+	}
+}
+
+func TestDownloadToProgressAndOffset(t *testing.T) {
+	content := []byte("id,reference,amount\n1,ref_1,20000\n2,ref_2,30000\n")
+	for _, supportsRanges := range []bool{true, false} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if supportsRanges {
+				http.ServeContent(w, r, "transactions.csv", time.Time{}, bytes.NewReader(content))
+				return
+			}
+			w.Write(content)
+		}))
+
+		client := NewAPIClient(WithSecretKey("sk_test"))
+		var last DownloadProgress
+		offset := int64(20)
+		var buf bytes.Buffer
+		buf.Write(content[:offset])
+		n, err := client.DownloadTo(context.Background(), server.URL, &buf, WithDownloadOffset(offset),
+			WithDownloadProgress(func(progress DownloadProgress) { last = progress }))
+		server.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(buf.Bytes(), content) || n != int64(len(content))-offset {
+			t.Fatalf("expected the download to be resumed, got %q", buf.Bytes())
+		}
+		expected := DownloadProgress{Bytes: int64(len(content)), Total: int64(len(content)), Rows: 2}
+		if last != expected {
+			t.Fatalf("expected progress %+v, got %+v", expected, last)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+)
+
+// WithDryRun lets you create an APIClient whose mutating calls, i.e. calls that are not GET requests, are
+// not sent to paystack. A synthetic successful response whose `data` is the payload is returned instead. If
+// the client has a logger set with WithLogger, the endpoint and payload of such calls are logged with it,
+// with the payload redacted with RedactJSON. Read calls are still sent to paystack. It is useful for
+// validating large payout or refund batches before executing them for real.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"), p.WithDryRun(),
+//		p.WithLogger(slog.Default()))
+//	// logs: paystack dry run method=POST path=/transfer/bulk payload={"source":"balance","transfers":[...]}
+//	resp, err := client.Transfers.BulkInitiate("balance", transfers)
+func WithDryRun() ClientOptions {
+	return func(client *APIClient) {
+		client.dryRun = true
+	}
+}
+
+// dryRunResponse logs a call that was not sent because of WithDryRun and returns its synthetic response
+func (a *baseAPIClient) dryRunResponse(ctx context.Context, method string, endPointPath string, body []byte) (*Response, error) {
+	if a.logger != nil {
+		a.logger.InfoContext(ctx, "paystack dry run", slog.String("method", method), slog.String("path", endPointPath),
+			slog.String("payload", string(RedactJSON(body))))
+	}
+	data := json.RawMessage(body)
+	if body == nil {
+		data = json.RawMessage("null")
+	}
+	synthetic, err := json.Marshal(envelope{Status: true, Message: "Dry run: request was not sent", Data: data})
+	if err != nil {
+		return nil, err
+	}
+	return &Response{StatusCode: http.StatusOK, Data: synthetic, endpoint: method + " " + endPointPath}, nil
+}
@@ -0,0 +1,137 @@
+package paystack
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Split types supported by paystack
+const (
+	SplitTypePercentage = "percentage"
+	SplitTypeFlat       = "flat"
+)
+
+// Bearer types of a split. The bearer of a split is charged the transaction fees.
+const (
+	SplitBearerAccount         = "account"
+	SplitBearerSubaccount      = "subaccount"
+	SplitBearerAllProportional = "all-proportional"
+	SplitBearerAll             = "all"
+)
+
+// ErrInvalidDynamicSplit is returned when a DynamicSplit is not one paystack accepts
+var ErrInvalidDynamicSplit = errors.New("invalid dynamic split")
+
+// SplitShare is the share of a subaccount in a split. Share is a percentage for a percentage split and
+// an amount in the subunit of the currency for a flat split.
+type SplitShare struct {
+	Subaccount string `json:"subaccount"`
+	Share      int    `json:"share"`
+}
+
+// DynamicSplit is a single use split that is sent along with a transaction instead of the split code
+// of a split created with TransactionSplitClient.Create. It should be created with a SplitBuilder
+// which validates it.
+type DynamicSplit struct {
+	Type             string       `json:"type"`
+	BearerType       string       `json:"bearer_type"`
+	BearerSubaccount string       `json:"bearer_subaccount,omitempty"`
+	Subaccounts      []SplitShare `json:"subaccounts"`
+}
+
+// Validate checks that the DynamicSplit is one paystack accepts. The shares of a percentage split must
+// not add up to more than 100 and the bearer subaccount must be part of the split.
+func (d DynamicSplit) Validate() error {
+	if d.Type != SplitTypePercentage && d.Type != SplitTypeFlat {
+		return fmt.Errorf("%w: unsupported type %q", ErrInvalidDynamicSplit, d.Type)
+	}
+	switch d.BearerType {
+	case SplitBearerAccount, SplitBearerAllProportional, SplitBearerAll:
+	case SplitBearerSubaccount:
+		if d.BearerSubaccount == "" {
+			return fmt.Errorf("%w: a bearer subaccount is required when the bearer type is %q",
+				ErrInvalidDynamicSplit, SplitBearerSubaccount)
+		}
+	default:
+		return fmt.Errorf("%w: unsupported bearer type %q", ErrInvalidDynamicSplit, d.BearerType)
+	}
+	if len(d.Subaccounts) == 0 {
+		return fmt.Errorf("%w: at least one subaccount is required", ErrInvalidDynamicSplit)
+	}
+
+	seen := make(map[string]bool)
+	total := 0
+	for _, share := range d.Subaccounts {
+		if share.Subaccount == "" {
+			return fmt.Errorf("%w: subaccount code is required", ErrInvalidDynamicSplit)
+		}
+		if seen[share.Subaccount] {
+			return fmt.Errorf("%w: subaccount %s is added more than once", ErrInvalidDynamicSplit, share.Subaccount)
+		}
+		seen[share.Subaccount] = true
+		if share.Share <= 0 {
+			return fmt.Errorf("%w: share of subaccount %s must be greater than 0", ErrInvalidDynamicSplit, share.Subaccount)
+		}
+		total += share.Share
+	}
+	if d.Type == SplitTypePercentage && total > 100 {
+		return fmt.Errorf("%w: shares add up to %d%%", ErrInvalidDynamicSplit, total)
+	}
+	if d.BearerType == SplitBearerSubaccount && !seen[d.BearerSubaccount] {
+		return fmt.Errorf("%w: bearer subaccount %s is not part of the split", ErrInvalidDynamicSplit, d.BearerSubaccount)
+	}
+	return nil
+}
+
+// SplitBuilder lets you build a valid DynamicSplit. It should not be instantiated directly but via the
+// NewSplitBuilder function.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	split, err := p.NewSplitBuilder(p.SplitTypePercentage).
+//		Add("ACCT_z3x6z3nbo14xsil", 20).
+//		Add("ACCT_pwwualwty4nhq9d", 30).
+//		Bearer(p.SplitBearerSubaccount, "ACCT_z3x6z3nbo14xsil").
+//		Build()
+type SplitBuilder struct {
+	split DynamicSplit
+}
+
+// NewSplitBuilder lets you create a SplitBuilder for a split of splitType, either SplitTypePercentage
+// or SplitTypeFlat. The main account bears the transaction fees unless SplitBuilder.Bearer is called.
+func NewSplitBuilder(splitType string) *SplitBuilder {
+	return &SplitBuilder{split: DynamicSplit{Type: splitType, BearerType: SplitBearerAccount}}
+}
+
+// Add lets you add the share of a subaccount to the split
+func (s *SplitBuilder) Add(subaccount string, share int) *SplitBuilder {
+	s.split.Subaccounts = append(s.split.Subaccounts, SplitShare{Subaccount: subaccount, Share: share})
+	return s
+}
+
+// Bearer lets you specify who bears the transaction fees. bearerSubaccount is only required when
+// bearerType is SplitBearerSubaccount.
+func (s *SplitBuilder) Bearer(bearerType string, bearerSubaccount string) *SplitBuilder {
+	s.split.BearerType = bearerType
+	s.split.BearerSubaccount = bearerSubaccount
+	return s
+}
+
+// Build returns the DynamicSplit after validating it
+func (s *SplitBuilder) Build() (DynamicSplit, error) {
+	split := s.split
+	split.Subaccounts = append([]SplitShare(nil), s.split.Subaccounts...)
+	if err := split.Validate(); err != nil {
+		return DynamicSplit{}, err
+	}
+	return split, nil
+}
+
+// WithDynamicSplit lets you send a DynamicSplit as the `split` of a payload e.g. when initializing a
+// transaction with TransactionClient.Initialize. Prefer TransactionClient.InitializeWithSplit which
+// validates the split before sending it.
+func WithDynamicSplit(split DynamicSplit) OptionalPayloadParameter {
+	return WithOptionalParameter("split", split)
+}
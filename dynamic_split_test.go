@@ -0,0 +1,40 @@
+package paystack
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestSplitBuilder(t *testing.T) {
+	split, err := NewSplitBuilder(SplitTypePercentage).
+		Add("ACCT_a", 20).
+		Add("ACCT_b", 30).
+		Bearer(SplitBearerSubaccount, "ACCT_a").
+		Build()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	encoded, err := json.Marshal(WithDynamicSplit(split)(map[string]interface{}{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"split":{"type":"percentage","bearer_type":"subaccount","bearer_subaccount":"ACCT_a",` +
+		`"subaccounts":[{"subaccount":"ACCT_a","share":20},{"subaccount":"ACCT_b","share":30}]}}`
+	if string(encoded) != want {
+		t.Fatalf("expected %s, got %s", want, encoded)
+	}
+
+	invalid := []*SplitBuilder{
+		NewSplitBuilder("shares").Add("ACCT_a", 20),
+		NewSplitBuilder(SplitTypePercentage),
+		NewSplitBuilder(SplitTypePercentage).Add("ACCT_a", 60).Add("ACCT_b", 50),
+		NewSplitBuilder(SplitTypeFlat).Add("ACCT_a", 1000).Add("ACCT_a", 500),
+		NewSplitBuilder(SplitTypeFlat).Add("ACCT_a", 1000).Bearer(SplitBearerSubaccount, "ACCT_b"),
+	}
+	for i, builder := range invalid {
+		if _, err := builder.Build(); !errors.Is(err, ErrInvalidDynamicSplit) {
+			t.Errorf("case %d: expected %v, got %v", i, ErrInvalidDynamicSplit, err)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package paystack
+
+import (
+	"strings"
+	"time"
+)
+
+// EndpointClass groups endpoints that share a timeout
+type EndpointClass = string
+
+const (
+	// EndpointClassDefault is the class of the endpoints that are not in any other class
+	EndpointClassDefault EndpointClass = "default"
+	// EndpointClassVerify is the class of the endpoints that verify a resource e.g. TransactionClient.Verify
+	EndpointClassVerify EndpointClass = "verify"
+	// EndpointClassExport is the class of the endpoints that export resources e.g. TransactionClient.Export
+	EndpointClassExport EndpointClass = "export"
+	// EndpointClassBulk is the class of the endpoints that operate on a batch of resources e.g.
+	// TransferClient.BulkInitiate
+	EndpointClassBulk EndpointClass = "bulk"
+)
+
+// defaultEndpointTimeouts are the timeouts of the endpoint classes used when they are not overridden
+// with WithEndpointTimeouts
+var defaultEndpointTimeouts = map[EndpointClass]time.Duration{
+	EndpointClassDefault: 30 * time.Second,
+	EndpointClassVerify:  15 * time.Second,
+	EndpointClassExport:  2 * time.Minute,
+	EndpointClassBulk:    time.Minute,
+}
+
+// WithEndpointTimeouts lets you override the timeouts of endpoint classes. Every request is given the
+// timeout of its class, which defaults to 30 seconds for EndpointClassDefault, 15 seconds for
+// EndpointClassVerify, 2 minutes for EndpointClassExport and a minute for EndpointClassBulk, or the timeout
+// of WithDefaultTimeout if provided. A timeout of 0 disables the timeout of a class.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"), p.WithEndpointTimeouts(
+//		map[p.EndpointClass]time.Duration{
+//			p.EndpointClassVerify: 5 * time.Second,
+//			p.EndpointClassExport: 5 * time.Minute,
+//		}))
+func WithEndpointTimeouts(timeouts map[EndpointClass]time.Duration) ClientOptions {
+	return func(client *APIClient) {
+		if client.endpointTimeouts == nil {
+			client.endpointTimeouts = make(map[EndpointClass]time.Duration)
+		}
+		for class, timeout := range timeouts {
+			client.endpointTimeouts[class] = timeout
+		}
+	}
+}
+
+// classifyEndpoint returns the EndpointClass of a request
+func classifyEndpoint(endPointPath string) EndpointClass {
+	path, _, _ := strings.Cut(endPointPath, "?")
+	switch {
+	case strings.Contains(path, "/export"):
+		return EndpointClassExport
+	case strings.Contains(path, "/bulk"):
+		return EndpointClassBulk
+	case strings.Contains(path, "/verify") || strings.HasPrefix(path, "/bank/resolve"):
+		return EndpointClassVerify
+	}
+	return EndpointClassDefault
+}
+
+// endpointTimeout returns the timeout of a request
+func (a *baseAPIClient) endpointTimeout(endPointPath string) time.Duration {
+	class := classifyEndpoint(endPointPath)
+	if timeout, ok := a.endpointTimeouts[class]; ok {
+		return timeout
+	}
+	if a.defaultTimeout > 0 {
+		return a.defaultTimeout
+	}
+	return defaultEndpointTimeouts[class]
+}
@@ -0,0 +1,48 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClassifyEndpoint(t *testing.T) {
+	for path, class := range map[string]EndpointClass{
+		"/transaction/verify/ref":    EndpointClassVerify,
+		"/transaction/export?from=1": EndpointClassExport,
+		"/transfer/bulk":             EndpointClassBulk,
+		"/bulkcharge":                EndpointClassBulk,
+		"/bank/resolve?bank_code=1":  EndpointClassVerify,
+		"/customer":                  EndpointClassDefault,
+	} {
+		if got := classifyEndpoint(path); got != class {
+			t.Errorf("classifyEndpoint(%q) = %q, expected %q", path, got, class)
+		}
+	}
+}
+
+func TestWithEndpointTimeouts(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL),
+		WithEndpointTimeouts(map[EndpointClass]time.Duration{EndpointClassVerify: 50 * time.Millisecond}))
+
+	start := time.Now()
+	_, err := client.Transactions.Verify("ref")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a deadline error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected the request to time out quickly, took %s", elapsed)
+	}
+}
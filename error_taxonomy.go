@@ -0,0 +1,114 @@
+package paystack
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	// ErrInsufficientFunds is matched by the *APIError of a request that failed because the balance of your
+	// Integration, or the account of the customer, can not cover the amount
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	// ErrDuplicateReference is matched by the *APIError of a request that failed because its reference has
+	// already been used
+	ErrDuplicateReference = errors.New("duplicate reference")
+	// ErrInvalidKey is matched by the *APIError of a request that failed because paystack rejected the secret key
+	ErrInvalidKey = errors.New("invalid secret key")
+	// ErrBlockedCustomer is matched by the *APIError of a request that failed because the customer has been
+	// blacklisted
+	ErrBlockedCustomer = errors.New("customer is blocked")
+	// ErrTransactionNotFound is matched by the *APIError of a request that failed because its transaction
+	// does not exist
+	ErrTransactionNotFound = errors.New("transaction not found")
+)
+
+// errorRule classifies the failures paystack returns with one of codes, or a message that contains all the
+// fragments of one of messages, as err
+type errorRule struct {
+	err      error
+	codes    []string
+	messages [][]string
+}
+
+// errorTaxonomy maps the codes and messages of known failures to stable errors. Paystack does not return a
+// code for every failure, so the lowercased message is matched as well.
+var errorTaxonomy = []errorRule{
+	{
+		err:      ErrInsufficientFunds,
+		codes:    []string{"insufficient_balance", "insufficient_funds"},
+		messages: [][]string{{"balance is not enough"}, {"insufficient funds"}, {"insufficient balance"}},
+	},
+	{
+		err:      ErrDuplicateReference,
+		codes:    []string{"duplicate_reference"},
+		messages: [][]string{{"duplicate", "reference"}, {"reference", "already exists"}},
+	},
+	{
+		err:      ErrInvalidKey,
+		codes:    []string{"invalid_key", "invalid_api_key"},
+		messages: [][]string{{"invalid key"}, {"invalid secret key"}, {"key", "is invalid"}},
+	},
+	{
+		err:      ErrBlockedCustomer,
+		codes:    []string{"customer_blacklisted", "blacklisted"},
+		messages: [][]string{{"customer", "blacklisted"}, {"customer", "blocked"}},
+	},
+	{
+		err:      ErrTransactionNotFound,
+		codes:    []string{"transaction_not_found"},
+		messages: [][]string{{"transaction reference not found"}, {"transaction not found"}},
+	},
+}
+
+// Is lets errors.Is match an *APIError against its class e.g. ErrNotFound or ErrServer, and the errors of
+// known failures e.g. ErrInsufficientFunds or ErrDuplicateReference, so that you do not have to match the
+// status codes and messages of paystack.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	resp, err := client.Transfers.Initiate("balance", 500000, "RCP_gx2wn530m0i3w3m")
+//	if err != nil {
+//		panic(err)
+//	}
+//	switch err = resp.AsError(); {
+//	case errors.Is(err, p.ErrInsufficientFunds):
+//		// top up the balance and retry later
+//	case errors.Is(err, p.ErrServer):
+//		// paystack is having an incident, retry later
+//	}
+func (e *APIError) Is(target error) bool {
+	if class := e.class(); class != nil && class == target {
+		return true
+	}
+	for _, rule := range errorTaxonomy {
+		if rule.err == target && rule.matches(e) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r errorRule) matches(e *APIError) bool {
+	code := strings.ToLower(e.Code)
+	for _, c := range r.codes {
+		if code == c {
+			return true
+		}
+	}
+	message := strings.ToLower(e.Message)
+	for _, fragments := range r.messages {
+		matched := true
+		for _, fragment := range fragments {
+			if !strings.Contains(message, fragment) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
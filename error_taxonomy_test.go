@@ -0,0 +1,31 @@
+package paystack
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestErrorTaxonomy(t *testing.T) {
+	for _, c := range []struct {
+		body     string
+		expected error
+	}{
+		{`{"status":false,"message":"Your balance is not enough to fulfil this request"}`, ErrInsufficientFunds},
+		{`{"status":false,"message":"Transfer failed","code":"insufficient_balance"}`, ErrInsufficientFunds},
+		{`{"status":false,"message":"Duplicate Transaction Reference"}`, ErrDuplicateReference},
+		{`{"status":false,"message":"Invalid key"}`, ErrInvalidKey},
+		{`{"status":false,"message":"Customer is blacklisted"}`, ErrBlockedCustomer},
+		{`{"status":false,"message":"Transaction reference not found"}`, ErrTransactionNotFound},
+	} {
+		err := (&Response{StatusCode: http.StatusBadRequest, Data: []byte(c.body)}).AsError()
+		if !errors.Is(err, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.body, c.expected, err)
+		}
+		for _, rule := range errorTaxonomy {
+			if rule.err != c.expected && errors.Is(err, rule.err) {
+				t.Errorf("%s: did not expect %v", c.body, rule.err)
+			}
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrRequestCanceled is wrapped by the error of a call whose context was canceled or exceeded its deadline
+// before paystack responded. The error also wraps the error of the context, so context.Canceled and
+// context.DeadlineExceeded can be checked with errors.Is to tell a user initiated abort from a deadline.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	responses, err := client.Transactions.VerifyMany(ctx, references, p.ParallelOptions{})
+//	var transportErr *p.TransportError
+//	switch {
+//	case errors.Is(err, p.ErrRequestCanceled):
+//		// the job was aborted, checkpoint and exit
+//	case errors.As(err, &transportErr):
+//		// paystack could not be reached, retry the job later
+//	}
+var ErrRequestCanceled = errors.New("paystack request canceled")
+
+// TransportError is returned when a request could not be sent to paystack or its response could not be
+// read, e.g. a connection failure or a request exceeding the timeout of its EndpointClass. It is not
+// returned when the context of the call is done, in which case the error wraps ErrRequestCanceled.
+type TransportError struct {
+	// Endpoint is the method and path of the request
+	Endpoint string
+	Err      error
+}
+
+func (e *TransportError) Error() string {
+	return fmt.Sprintf("paystack: %s: %v", e.Endpoint, e.Err)
+}
+
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// requestError classifies err, the error of sending the request to endpoint with ctx, as a cancellation
+// of the call or a TransportError
+func requestError(ctx context.Context, endpoint string, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return fmt.Errorf("%w: %s: %w", ErrRequestCanceled, endpoint, ctxErr)
+	}
+	return &TransportError{Endpoint: endpoint, Err: err}
+}
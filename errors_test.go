@@ -0,0 +1,64 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRequestCanceledAndTransportErrors(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := client.Transactions.FetchByReference(ctx, "ref")
+	var transportErr *TransportError
+	if !errors.Is(err, ErrRequestCanceled) || !errors.Is(err, context.DeadlineExceeded) || errors.As(err, &transportErr) {
+		t.Fatalf("expected a canceled request error, got %v", err)
+	}
+
+	// a request exceeding the timeout of its class is a failure of paystack, not an abort by the caller
+	client = NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL),
+		WithEndpointTimeouts(map[EndpointClass]time.Duration{EndpointClassVerify: 20 * time.Millisecond}))
+	_, err = client.Transactions.Verify("ref")
+	if !errors.As(err, &transportErr) || errors.Is(err, ErrRequestCanceled) {
+		t.Fatalf("expected a transport error, got %v", err)
+	}
+	if transportErr.Endpoint != "GET /transaction/verify/ref" {
+		t.Fatalf("unexpected endpoint %q", transportErr.Endpoint)
+	}
+}
+
+func TestRetriesAbortOnCancellation(t *testing.T) {
+	defer func(backoff time.Duration) { retryBackoff = backoff }(retryBackoff)
+	retryBackoff = time.Hour
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL), WithRetryBudget(3, 60))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := client.Transactions.FetchByReference(ctx, "ref")
+	if !errors.Is(err, ErrRequestCanceled) {
+		t.Fatalf("expected a canceled request error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected the retry to be abandoned on cancellation, took %s", elapsed)
+	}
+}
@@ -0,0 +1,59 @@
+package experimental
+
+import (
+	"fmt"
+	"net/http"
+
+	p "github.com/gray-adeyi/paystack"
+)
+
+// AuthorizationChannel is the channel an authorization is created on with
+// DirectDebitClient.InitializeAuthorization
+type AuthorizationChannel = string
+
+// ChannelDirectDebit creates an authorization that debits the bank account of a customer
+const ChannelDirectDebit AuthorizationChannel = "direct_debit"
+
+// DirectDebitClient interacts with the endpoints that tokenize the bank accounts of customers into
+// authorizations for direct debit. It should not be instantiated directly but via the New function.
+type DirectDebitClient struct {
+	client *p.APIClient
+}
+
+// InitializeAuthorization lets you start tokenizing the payment method of a customer. The response contains
+// a `redirect_url` the customer completes the authorization on and the `reference` of the authorization.
+//
+// Example
+//
+//	import (
+//		p "github.com/gray-adeyi/paystack"
+//		"github.com/gray-adeyi/paystack/experimental"
+//	)
+//
+//	beta := experimental.New(p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>")))
+//	resp, err := beta.DirectDebit.InitializeAuthorization("janedoe@example.com", experimental.ChannelDirectDebit,
+//		p.WithOptionalParameter("callback_url", "https://example.com/authorized"))
+func (d *DirectDebitClient) InitializeAuthorization(email string, channel AuthorizationChannel,
+	optionalPayloadParameters ...p.OptionalPayloadParameter) (*p.Response, error) {
+	payload := map[string]interface{}{"email": email, "channel": channel}
+	payload = applyOptionalParameters(payload, optionalPayloadParameters)
+	return d.client.APICall(http.MethodPost, "/customer/authorization/initialize", payload)
+}
+
+// VerifyAuthorization lets you check the status of an authorization started with InitializeAuthorization
+func (d *DirectDebitClient) VerifyAuthorization(reference string) (*p.Response, error) {
+	return d.client.APICall(http.MethodGet, fmt.Sprintf("/customer/authorization/verify/%s", reference), nil)
+}
+
+// MandateAuthorizations lets you retrieve the direct debit mandates on your Integration
+func (d *DirectDebitClient) MandateAuthorizations(queries ...p.Query) (*p.Response, error) {
+	url := p.AddQueryParamsToUrl("/directdebit/mandate-authorizations", queries...)
+	return d.client.APICall(http.MethodGet, url, nil)
+}
+
+// TriggerActivationCharge lets you trigger the activation charge of the direct debit mandates of customers
+// whose mandates are pending activation
+func (d *DirectDebitClient) TriggerActivationCharge(customerIds []int) (*p.Response, error) {
+	payload := map[string]interface{}{"customer_ids": customerIds}
+	return d.client.APICall(http.MethodPut, "/directdebit/activation-charge", payload)
+}
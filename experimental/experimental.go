@@ -0,0 +1,51 @@
+// Package experimental contains clients for paystack endpoints that are in beta or were recently released.
+// They ship quickly so that you can try new features early, but they come with no compatibility
+// guarantees: their methods may change or be removed in any release, including patch releases, as the
+// endpoints evolve. Clients graduate to the paystack package once their endpoints are stable.
+//
+// The clients must be created explicitly from an APIClient with New, so that depending on an unstable API
+// is always a deliberate choice. They share the configuration of the APIClient e.g. its secret key,
+// retries and middlewares.
+//
+// Example
+//
+//	import (
+//		p "github.com/gray-adeyi/paystack"
+//		"github.com/gray-adeyi/paystack/experimental"
+//	)
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	beta := experimental.New(client)
+//	resp, err := beta.DirectDebit.InitializeAuthorization("janedoe@example.com", experimental.ChannelDirectDebit)
+package experimental
+
+import (
+	p "github.com/gray-adeyi/paystack"
+)
+
+// Client gives access to the experimental clients. It should not be instantiated directly but via the New
+// function.
+type Client struct {
+	// DirectDebit lets you interact with the endpoints that tokenize the bank accounts of customers for
+	// direct debit
+	DirectDebit *DirectDebitClient
+
+	// VirtualTerminals lets you interact with the endpoints of paystack's virtual terminal, which lets you
+	// accept in-person payments without a physical terminal
+	VirtualTerminals *VirtualTerminalClient
+}
+
+// New lets you create the experimental clients of an APIClient
+func New(client *p.APIClient) *Client {
+	return &Client{
+		DirectDebit:      &DirectDebitClient{client},
+		VirtualTerminals: &VirtualTerminalClient{client},
+	}
+}
+
+func applyOptionalParameters(payload map[string]interface{}, optionalPayloadParameters []p.OptionalPayloadParameter) map[string]interface{} {
+	for _, optionalPayloadParameter := range optionalPayloadParameters {
+		payload = optionalPayloadParameter(payload)
+	}
+	return payload
+}
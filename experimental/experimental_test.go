@@ -0,0 +1,45 @@
+package experimental
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	p "github.com/gray-adeyi/paystack"
+)
+
+func TestExperimentalClients(t *testing.T) {
+	type request struct {
+		method  string
+		path    string
+		payload map[string]interface{}
+	}
+	requests := make(chan request, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var payload map[string]interface{}
+		json.Unmarshal(body, &payload)
+		requests <- request{r.Method, r.URL.Path, payload}
+		w.Write([]byte(`{"status":true}`))
+	}))
+	defer server.Close()
+	beta := New(p.NewAPIClient(p.WithSecretKey("sk_test"), p.WithBaseUrl(server.URL)))
+
+	if _, err := beta.DirectDebit.InitializeAuthorization("janedoe@example.com", ChannelDirectDebit,
+		p.WithOptionalParameter("callback_url", "https://example.com")); err != nil {
+		t.Fatal(err)
+	}
+	r := <-requests
+	if r.method != http.MethodPost || r.path != "/customer/authorization/initialize" ||
+		r.payload["channel"] != ChannelDirectDebit || r.payload["callback_url"] != "https://example.com" {
+		t.Fatalf("unexpected request %+v", r)
+	}
+	if _, err := beta.VirtualTerminals.Deactivate("VT_1"); err != nil {
+		t.Fatal(err)
+	}
+	if r = <-requests; r.method != http.MethodPut || r.path != "/virtual_terminal/VT_1/deactivate" {
+		t.Fatalf("unexpected request %+v", r)
+	}
+}
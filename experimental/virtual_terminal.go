@@ -0,0 +1,56 @@
+package experimental
+
+import (
+	"fmt"
+	"net/http"
+
+	p "github.com/gray-adeyi/paystack"
+)
+
+// VirtualTerminalDestination is a WhatsApp number notified of the payments made on a virtual terminal
+type VirtualTerminalDestination struct {
+	Target string `json:"target"`
+	Name   string `json:"name"`
+}
+
+// VirtualTerminalClient interacts with the endpoints of paystack's virtual terminal. It should not be
+// instantiated directly but via the New function.
+type VirtualTerminalClient struct {
+	client *p.APIClient
+}
+
+// Create lets you create a virtual terminal
+//
+// Example
+//
+//	import (
+//		p "github.com/gray-adeyi/paystack"
+//		"github.com/gray-adeyi/paystack/experimental"
+//	)
+//
+//	beta := experimental.New(p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>")))
+//	resp, err := beta.VirtualTerminals.Create("Sales Point #1", []experimental.VirtualTerminalDestination{
+//		{Target: "+2348123456789", Name: "Jane"},
+//	})
+func (v *VirtualTerminalClient) Create(name string, destinations []VirtualTerminalDestination,
+	optionalPayloadParameters ...p.OptionalPayloadParameter) (*p.Response, error) {
+	payload := map[string]interface{}{"name": name, "destinations": destinations}
+	payload = applyOptionalParameters(payload, optionalPayloadParameters)
+	return v.client.APICall(http.MethodPost, "/virtual_terminal", payload)
+}
+
+// All lets you retrieve the virtual terminals on your Integration
+func (v *VirtualTerminalClient) All(queries ...p.Query) (*p.Response, error) {
+	url := p.AddQueryParamsToUrl("/virtual_terminal", queries...)
+	return v.client.APICall(http.MethodGet, url, nil)
+}
+
+// FetchOne lets you retrieve a virtual terminal
+func (v *VirtualTerminalClient) FetchOne(code string) (*p.Response, error) {
+	return v.client.APICall(http.MethodGet, fmt.Sprintf("/virtual_terminal/%s", code), nil)
+}
+
+// Deactivate lets you deactivate a virtual terminal so that it no longer accepts payments
+func (v *VirtualTerminalClient) Deactivate(code string) (*p.Response, error) {
+	return v.client.APICall(http.MethodPut, fmt.Sprintf("/virtual_terminal/%s/deactivate", code), nil)
+}
@@ -0,0 +1,205 @@
+package paystack
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// FlatTransaction is a denormalized transaction. The nested customer and authorization of a transaction
+// are flattened into top level fields, making FlatTransaction suitable for loading into analytics stores
+// and data warehouses like BigQuery. Use FlatTransactionJSONSchema or FlatTransactionAvroSchema to
+// create the table or schema of the destination. The times of a FlatTransaction are marshaled as RFC 3339
+// strings, which is how both schemas declare them.
+type FlatTransaction struct {
+	Id                    int64      `json:"id"`
+	Domain                string     `json:"domain"`
+	Reference             string     `json:"reference"`
+	Status                string     `json:"status"`
+	Amount                int64      `json:"amount"`
+	Fees                  int64      `json:"fees"`
+	Currency              string     `json:"currency"`
+	Channel               string     `json:"channel"`
+	GatewayResponse       string     `json:"gateway_response"`
+	IpAddress             string     `json:"ip_address"`
+	PaidAt                *time.Time `json:"paid_at"`
+	CreatedAt             time.Time  `json:"created_at"`
+	CustomerId            int64      `json:"customer_id"`
+	CustomerCode          string     `json:"customer_code"`
+	CustomerEmail         string     `json:"customer_email"`
+	AuthorizationCode     string     `json:"authorization_code"`
+	AuthorizationLast4    string     `json:"authorization_last4"`
+	AuthorizationBrand    string     `json:"authorization_brand"`
+	AuthorizationBank     string     `json:"authorization_bank"`
+	AuthorizationCardType string     `json:"authorization_card_type"`
+	// Metadata is the metadata of the transaction serialized as JSON
+	Metadata string `json:"metadata"`
+}
+
+// FlattenTransaction lets you create a FlatTransaction from the JSON of a transaction, e.g. an item of
+// the `data` of the response of TransactionClient.All or the `data` of the response of
+// TransactionClient.Verify.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	resp, err := client.Transactions.All()
+//	if err != nil {
+//		panic(err)
+//	}
+//	var transactions struct {
+//		Data []json.RawMessage `json:"data"`
+//	}
+//	if err = resp.Decode(&transactions); err != nil {
+//		panic(err)
+//	}
+//	for _, transaction := range transactions.Data {
+//		flat, err := p.FlattenTransaction(transaction)
+//		if err != nil {
+//			panic(err)
+//		}
+//		fmt.Println(flat.CustomerEmail, flat.AuthorizationLast4)
+//	}
+func FlattenTransaction(transaction json.RawMessage) (FlatTransaction, error) {
+	var t struct {
+		Id              int64           `json:"id"`
+		Domain          string          `json:"domain"`
+		Reference       string          `json:"reference"`
+		Status          string          `json:"status"`
+		Amount          int64           `json:"amount"`
+		Fees            int64           `json:"fees"`
+		Currency        string          `json:"currency"`
+		Channel         string          `json:"channel"`
+		GatewayResponse string          `json:"gateway_response"`
+		IpAddress       string          `json:"ip_address"`
+		PaidAt          *time.Time      `json:"paid_at"`
+		Metadata        json.RawMessage `json:"metadata"`
+		Customer        struct {
+			Id           int64  `json:"id"`
+			CustomerCode string `json:"customer_code"`
+			Email        string `json:"email"`
+		} `json:"customer"`
+		Authorization struct {
+			AuthorizationCode string `json:"authorization_code"`
+			Last4             string `json:"last4"`
+			Brand             string `json:"brand"`
+			Bank              string `json:"bank"`
+			CardType          string `json:"card_type"`
+		} `json:"authorization"`
+	}
+	if err := json.Unmarshal(transaction, &t); err != nil {
+		return FlatTransaction{}, err
+	}
+	flat := FlatTransaction{
+		Id:                    t.Id,
+		Domain:                t.Domain,
+		Reference:             t.Reference,
+		Status:                t.Status,
+		Amount:                t.Amount,
+		Fees:                  t.Fees,
+		Currency:              t.Currency,
+		Channel:               t.Channel,
+		GatewayResponse:       t.GatewayResponse,
+		IpAddress:             t.IpAddress,
+		PaidAt:                t.PaidAt,
+		CreatedAt:             recordCreatedAt(transaction),
+		CustomerId:            t.Customer.Id,
+		CustomerCode:          t.Customer.CustomerCode,
+		CustomerEmail:         t.Customer.Email,
+		AuthorizationCode:     t.Authorization.AuthorizationCode,
+		AuthorizationLast4:    t.Authorization.Last4,
+		AuthorizationBrand:    t.Authorization.Brand,
+		AuthorizationBank:     t.Authorization.Bank,
+		AuthorizationCardType: t.Authorization.CardType,
+	}
+	if metadata := string(t.Metadata); metadata != "" && metadata != "null" && metadata != `""` {
+		flat.Metadata = metadata
+	}
+	return flat, nil
+}
+
+// FlatTransactionJSONSchema returns the JSON schema of FlatTransaction
+func FlatTransactionJSONSchema() []byte {
+	properties := make(map[string]interface{})
+	var required []string
+	for _, field := range schemaFields(reflect.TypeOf(FlatTransaction{})) {
+		property := map[string]interface{}{"type": field.jsonType}
+		if field.isTime {
+			property["format"] = "date-time"
+		}
+		if field.nullable {
+			property["type"] = []string{field.jsonType, "null"}
+		} else {
+			required = append(required, field.name)
+		}
+		properties[field.name] = property
+	}
+	schema, _ := json.MarshalIndent(map[string]interface{}{
+		"$schema":    "https://json-schema.org/draft/2020-12/schema",
+		"title":      "FlatTransaction",
+		"type":       "object",
+		"properties": properties,
+		"required":   required,
+	}, "", "  ")
+	return schema
+}
+
+// FlatTransactionAvroSchema returns the Avro schema of FlatTransaction. The times are declared as strings
+// rather than timestamp-millis, since a FlatTransaction marshals them as RFC 3339 strings.
+func FlatTransactionAvroSchema() []byte {
+	var fields []map[string]interface{}
+	for _, field := range schemaFields(reflect.TypeOf(FlatTransaction{})) {
+		avroField := map[string]interface{}{"name": field.name, "type": field.avroType}
+		if field.nullable {
+			// the default of a union must match its first type
+			avroField["type"], avroField["default"] = []interface{}{"null", field.avroType}, nil
+		}
+		fields = append(fields, avroField)
+	}
+	schema, _ := json.MarshalIndent(map[string]interface{}{
+		"type":      "record",
+		"name":      "FlatTransaction",
+		"namespace": "co.paystack",
+		"fields":    fields,
+	}, "", "  ")
+	return schema
+}
+
+type schemaField struct {
+	name     string
+	jsonType string
+	avroType string
+	isTime   bool
+	nullable bool
+}
+
+// schemaFields describes the fields of a flat struct for schema generation
+func schemaFields(t reflect.Type) []schemaField {
+	timeType := reflect.TypeOf(time.Time{})
+	var fields []schemaField
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		name, _, _ := strings.Cut(structField.Tag.Get("json"), ",")
+		fieldType := structField.Type
+		field := schemaField{name: name}
+		if fieldType.Kind() == reflect.Pointer {
+			field.nullable = true
+			fieldType = fieldType.Elem()
+		}
+		switch {
+		case fieldType == timeType:
+			field.jsonType, field.avroType, field.isTime = "string", "string", true
+		case fieldType.Kind() == reflect.Int64 || fieldType.Kind() == reflect.Int:
+			field.jsonType, field.avroType = "integer", "long"
+		case fieldType.Kind() == reflect.Bool:
+			field.jsonType, field.avroType = "boolean", "boolean"
+		default:
+			field.jsonType, field.avroType = "string", "string"
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
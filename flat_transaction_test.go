@@ -0,0 +1,165 @@
+package paystack
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const flatTransactionFixture = `{
+	"id": 4099260516,
+	"domain": "test",
+	"reference": "re4lyvq3s3",
+	"status": "success",
+	"amount": 40333,
+	"fees": 10,
+	"currency": "NGN",
+	"channel": "card",
+	"gateway_response": "Successful",
+	"ip_address": "197.210.54.33",
+	"paid_at": "2024-08-22T09:15:02.000Z",
+	"created_at": "2024-08-22T09:14:24.000Z",
+	"metadata": {"cart_id": 398},
+	"customer": {"id": 181873746, "customer_code": "CUS_1rkzaqsv4rrhqo6", "email": "demo@test.com"},
+	"authorization": {"authorization_code": "AUTH_uh8bcl3zbn", "last4": "4081", "brand": "visa",
+		"bank": "TEST BANK", "card_type": "visa "}
+}`
+
+func TestFlattenTransaction(t *testing.T) {
+	flat, err := FlattenTransaction(json.RawMessage(flatTransactionFixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+	paidAt := time.Date(2024, 8, 22, 9, 15, 2, 0, time.UTC)
+	want := FlatTransaction{
+		Id:                    4099260516,
+		Domain:                "test",
+		Reference:             "re4lyvq3s3",
+		Status:                "success",
+		Amount:                40333,
+		Fees:                  10,
+		Currency:              "NGN",
+		Channel:               "card",
+		GatewayResponse:       "Successful",
+		IpAddress:             "197.210.54.33",
+		PaidAt:                &paidAt,
+		CreatedAt:             time.Date(2024, 8, 22, 9, 14, 24, 0, time.UTC),
+		CustomerId:            181873746,
+		CustomerCode:          "CUS_1rkzaqsv4rrhqo6",
+		CustomerEmail:         "demo@test.com",
+		AuthorizationCode:     "AUTH_uh8bcl3zbn",
+		AuthorizationLast4:    "4081",
+		AuthorizationBrand:    "visa",
+		AuthorizationBank:     "TEST BANK",
+		AuthorizationCardType: "visa ",
+		Metadata:              `{"cart_id": 398}`,
+	}
+	if !flat.PaidAt.Equal(paidAt) || !flat.CreatedAt.Equal(want.CreatedAt) {
+		t.Fatalf("expected the times of the transaction, got %v and %v", flat.PaidAt, flat.CreatedAt)
+	}
+	flat.PaidAt, flat.CreatedAt = want.PaidAt, want.CreatedAt
+	if !reflect.DeepEqual(flat, want) {
+		t.Fatalf("expected %+v, got %+v", want, flat)
+	}
+
+	for _, transaction := range []string{
+		`{"id": 1, "paid_at": null, "metadata": null}`,
+		`{"id": 1, "metadata": ""}`,
+	} {
+		flat, err = FlattenTransaction(json.RawMessage(transaction))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if flat.PaidAt != nil || flat.Metadata != "" || !flat.CreatedAt.IsZero() {
+			t.Fatalf("expected the missing fields of %s to be empty, got %+v", transaction, flat)
+		}
+	}
+	if _, err = FlattenTransaction(json.RawMessage(`[]`)); err == nil {
+		t.Fatal("expected a transaction that is not an object to be an error")
+	}
+}
+
+func TestFlatTransactionJSONSchema(t *testing.T) {
+	var schema struct {
+		Properties map[string]struct {
+			Type   interface{} `json:"type"`
+			Format string      `json:"format"`
+		} `json:"properties"`
+		Required []string `json:"required"`
+	}
+	if err := json.Unmarshal(FlatTransactionJSONSchema(), &schema); err != nil {
+		t.Fatal(err)
+	}
+	if len(schema.Properties) != reflect.TypeOf(FlatTransaction{}).NumField() {
+		t.Fatalf("expected a property for each field, got %d", len(schema.Properties))
+	}
+	if paidAt := schema.Properties["paid_at"]; !reflect.DeepEqual(paidAt.Type, []interface{}{"string", "null"}) || paidAt.Format != "date-time" {
+		t.Fatalf("expected paid_at to be a nullable date-time, got %+v", paidAt)
+	}
+	if createdAt := schema.Properties["created_at"]; createdAt.Type != "string" || createdAt.Format != "date-time" {
+		t.Fatalf("expected created_at to be a date-time, got %+v", createdAt)
+	}
+	if amount := schema.Properties["amount"]; amount.Type != "integer" {
+		t.Fatalf("expected amount to be an integer, got %+v", amount)
+	}
+	for _, name := range schema.Required {
+		if name == "paid_at" {
+			t.Fatal("expected paid_at not to be required")
+		}
+	}
+	if len(schema.Required) != len(schema.Properties)-1 {
+		t.Fatalf("expected every other property to be required, got %v", schema.Required)
+	}
+}
+
+func TestFlatTransactionAvroSchema(t *testing.T) {
+	var schema struct {
+		Type   string `json:"type"`
+		Name   string `json:"name"`
+		Fields []map[string]interface{}
+	}
+	if err := json.Unmarshal(FlatTransactionAvroSchema(), &schema); err != nil {
+		t.Fatal(err)
+	}
+	if schema.Type != "record" || schema.Name != "FlatTransaction" {
+		t.Fatalf("expected a FlatTransaction record, got %+v", schema)
+	}
+	types := make(map[string]interface{})
+	for _, field := range schema.Fields {
+		types[field["name"].(string)] = field["type"]
+		if _, ok := field["default"]; ok != (field["name"] == "paid_at") {
+			t.Errorf("expected only the nullable paid_at to have a default, got %v", field)
+		}
+	}
+	if !reflect.DeepEqual(types["paid_at"], []interface{}{"null", "string"}) {
+		t.Fatalf("expected paid_at to be a nullable string, got %v", types["paid_at"])
+	}
+
+	// every field of a marshaled FlatTransaction must be of the type its Avro field declares
+	paidAt := time.Now()
+	data, _ := json.Marshal(FlatTransaction{PaidAt: &paidAt, CreatedAt: time.Now()})
+	var record map[string]interface{}
+	json.Unmarshal(data, &record)
+	if len(record) != len(types) {
+		t.Fatalf("expected a field for each key of the record, got %d fields and %d keys", len(types), len(record))
+	}
+	for name, value := range record {
+		avroType := types[name]
+		if union, ok := avroType.([]interface{}); ok {
+			avroType = union[1]
+		}
+		var got string
+		switch value.(type) {
+		case string:
+			got = "string"
+		case float64:
+			got = "long"
+		case bool:
+			got = "boolean"
+		}
+		if got != avroType {
+			t.Errorf("expected %s to be marshaled as %v, got %T", name, avroType, value)
+		}
+	}
+}
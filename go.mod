@@ -1,3 +1,3 @@
 module github.com/gray-adeyi/paystack
 
-go 1.20
+go 1.21
@@ -0,0 +1,57 @@
+package paystack
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strings"
+)
+
+// gzipEndpoints are the bulk endpoints whose payloads are compressed when WithGzipRequests is used
+var gzipEndpoints = []string{"/transfer/bulk", "/transferrecipient/bulk", "/bulkcharge"}
+
+// WithGzipRequests lets you compress the payloads of bulk endpoints (Transfers.BulkInitiate,
+// TransferRecipients.BulkCreate and BulkCharges.Initiate) that are at least minSize bytes with gzip. This
+// reduces the bandwidth used by batch heavy workloads. Only enable it when the API accepts gzip encoded
+// payloads in your environment, e.g. when an egress proxy decompresses them.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"), p.WithGzipRequests(64<<10))
+func WithGzipRequests(minSize int) ClientOptions {
+	return func(client *APIClient) {
+		client.gzipMinSize = minSize
+	}
+}
+
+// compressPayload compresses body with gzip if WithGzipRequests is enabled and endPointPath is a bulk
+// endpoint. The content encoding of the returned body is returned alongside it.
+func (a *baseAPIClient) compressPayload(endPointPath string, body []byte) ([]byte, string, error) {
+	if a.gzipMinSize <= 0 || len(body) < a.gzipMinSize || !isGzipEndpoint(endPointPath) {
+		return body, "", nil
+	}
+	buf := bufferPool.Get().(*bytes.Buffer)
+	defer func() {
+		buf.Reset()
+		bufferPool.Put(buf)
+	}()
+	writer := gzip.NewWriter(buf)
+	if _, err := writer.Write(body); err != nil {
+		return nil, "", err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+	return append([]byte(nil), buf.Bytes()...), "gzip", nil
+}
+
+func isGzipEndpoint(endPointPath string) bool {
+	path, _, _ := strings.Cut(endPointPath, "?")
+	for _, endpoint := range gzipEndpoints {
+		if strings.TrimRight(path, "/") == endpoint {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// HealthSnapshot is an overview of the state of your Integration. It is returned by APIClient.HealthSnapshot
+// and it is suitable for status pages.
+type HealthSnapshot struct {
+	// Balances is the available balance of your Integration per currency
+	Balances map[string]int
+	// PendingTransfers is the number of transfers that are pending
+	PendingTransfers int
+	// UnresolvedDisputes is the number of disputes awaiting feedback from you or the bank
+	UnresolvedDisputes int
+	// FailingWebhooks is the number of resources whose latest webhook event failed to be processed.
+	// It is only populated when a WebhookHandler is provided to APIClient.HealthSnapshot.
+	FailingWebhooks int
+	CheckedAt       time.Time
+}
+
+// HealthSnapshot lets you retrieve an overview of the state of your Integration. It aggregates the balance
+// of your Integration per currency, the number of pending transfers and the number of unresolved disputes.
+// If webhooks is not nil, the number of resources whose latest webhook event failed is included. An error
+// is returned if any of the numbers can not be determined, so that a status page never reports a guess.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	webhookHandler := p.NewWebhookHandler("<paystack-secret-key>")
+//	snapshot, err := client.HealthSnapshot(context.TODO(), webhookHandler)
+//	if err != nil {
+//		panic(err)
+//	}
+//	fmt.Println(snapshot.Balances["NGN"], snapshot.PendingTransfers, snapshot.UnresolvedDisputes)
+func (a *APIClient) HealthSnapshot(ctx context.Context, webhooks *WebhookHandler) (HealthSnapshot, error) {
+	snapshot := HealthSnapshot{Balances: make(map[string]int), CheckedAt: time.Now()}
+
+	resp, err := a.apiCall(ctx, http.MethodGet, "/balance", nil)
+	if err != nil {
+		return snapshot, err
+	}
+	var balances struct {
+		Status  bool   `json:"status"`
+		Message string `json:"message"`
+		Data    []struct {
+			Currency string `json:"currency"`
+			Balance  int    `json:"balance"`
+		} `json:"data"`
+	}
+	if err = resp.Decode(&balances); err != nil {
+		return snapshot, err
+	}
+	if !balances.Status {
+		return snapshot, fmt.Errorf("unable to retrieve balance: %s", balances.Message)
+	}
+	for _, balance := range balances.Data {
+		snapshot.Balances[balance.Currency] = balance.Balance
+	}
+
+	snapshot.PendingTransfers, err = a.countRecords(ctx, "/transfer", WithQuery("status", "pending"))
+	if err != nil {
+		return snapshot, err
+	}
+	for _, status := range []string{"awaiting-merchant-feedback", "awaiting-bank-feedback"} {
+		count, err := a.countRecords(ctx, "/dispute", WithQuery("status", status))
+		if err != nil {
+			return snapshot, err
+		}
+		snapshot.UnresolvedDisputes += count
+	}
+
+	if webhooks != nil {
+		snapshot.FailingWebhooks = webhooks.FailingResources()
+	}
+	return snapshot, nil
+}
+
+// countRecords retrieves the total number of records of the list endpoint at endPointPath matching queries
+func (a *APIClient) countRecords(ctx context.Context, endPointPath string, queries ...Query) (int, error) {
+	url := AddQueryParamsToUrl(endPointPath, append([]Query{WithQuery("perPage", "1")}, queries...)...)
+	resp, err := a.apiCall(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	var body struct {
+		Status  bool           `json:"status"`
+		Message string         `json:"message"`
+		Meta    paginationMeta `json:"meta"`
+	}
+	if err = resp.Decode(&body); err != nil {
+		return 0, err
+	}
+	if !body.Status {
+		return 0, fmt.Errorf("unable to count records: %s", body.Message)
+	}
+	total, err := body.Meta.Total.Int64()
+	if err != nil {
+		return 0, fmt.Errorf("unable to count records of %s: invalid total %q", endPointPath, body.Meta.Total)
+	}
+	return int(total), nil
+}
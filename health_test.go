@@ -0,0 +1,38 @@
+package paystack
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHealthSnapshot(t *testing.T) {
+	newClient := func(transfers string) *APIClient {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/balance":
+				w.Write([]byte(`{"status":true,"data":[{"currency":"NGN","balance":1000}]}`))
+			case "/transfer":
+				w.Write([]byte(transfers))
+			case "/dispute":
+				w.Write([]byte(`{"status":true,"data":[],"meta":{"total":2}}`))
+			}
+		}))
+		t.Cleanup(server.Close)
+		return NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+	}
+
+	snapshot, err := newClient(`{"status":true,"data":[],"meta":{"total":3}}`).HealthSnapshot(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snapshot.Balances["NGN"] != 1000 || snapshot.PendingTransfers != 3 || snapshot.UnresolvedDisputes != 4 {
+		t.Fatalf("unexpected snapshot %+v", snapshot)
+	}
+
+	_, err = newClient(`{"status":true,"data":[],"meta":{}}`).HealthSnapshot(context.Background(), nil)
+	if err == nil {
+		t.Fatal("expected a missing total to be an error rather than a count of 0")
+	}
+}
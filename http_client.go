@@ -0,0 +1,95 @@
+package paystack
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// WithHTTPClient lets you create an APIClient that sends its requests with httpClient, e.g. to set your own
+// timeouts, proxies or cookie jar. httpClient is shared by all the clients of the APIClient. It is copied,
+// so it is not modified by the other options of the APIClient e.g. WithMiddleware.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	httpClient := &http.Client{Timeout: 30 * time.Second}
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"), p.WithHTTPClient(httpClient))
+func WithHTTPClient(httpClient *http.Client) ClientOptions {
+	return func(client *APIClient) {
+		if httpClient == nil {
+			return
+		}
+		httpClientCopy := *httpClient
+		client.httpClient = &httpClientCopy
+	}
+}
+
+// WithTransport lets you create an APIClient that sends its requests through transport, e.g. an instrumented
+// http.RoundTripper or one routed through a proxy. It takes precedence over the transport of a client provided
+// with WithHTTPClient and WithTunedTransport has no effect when it is provided. The middlewares of
+// WithMiddleware wrap transport.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	transport := http.DefaultTransport.(*http.Transport).Clone()
+//	transport.Proxy = http.ProxyURL(egressProxyUrl)
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"), p.WithTransport(transport))
+func WithTransport(transport http.RoundTripper) ClientOptions {
+	return func(client *APIClient) {
+		client.transport = transport
+	}
+}
+
+// ErrInsecureSkipVerifyRefused is wrapped by the error of every call of an APIClient created with
+// WithInsecureSkipVerify that could not disable TLS certificate verification
+var ErrInsecureSkipVerifyRefused = errors.New("paystack: WithInsecureSkipVerify refused")
+
+// buildTransport composes the transport of an APIClient from its options. An error is returned alongside
+// the transport if WithInsecureSkipVerify could not take effect.
+func (a *baseAPIClient) buildTransport() (http.RoundTripper, error) {
+	var err error
+	transport := a.transport
+	if transport == nil {
+		transport = a.httpClient.Transport
+	}
+	if transport == nil && a.dnsCacheTTL > 0 {
+		transport = newTunedTransport(a.dnsCacheTTL)
+	}
+	if a.insecureSkipVerify {
+		transport, err = insecureTransport(transport, a.baseUrl)
+	}
+	if len(a.middlewares) > 0 {
+		transport = applyMiddlewares(transport, a.middlewares)
+	}
+	return transport, err
+}
+
+// insecureTransport returns a copy of transport that skips TLS certificate verification. transport is
+// returned as is alongside an error wrapping ErrInsecureSkipVerifyRefused if baseUrl is paystack's
+// production host or transport is not an *http.Transport, since there is no way to configure it.
+func insecureTransport(transport http.RoundTripper, baseUrl string) (http.RoundTripper, error) {
+	if isProductionBaseUrl(baseUrl) {
+		return transport, fmt.Errorf("%w: certificate verification can not be disabled for %s",
+			ErrInsecureSkipVerifyRefused, baseUrl)
+	}
+	var insecure *http.Transport
+	switch t := transport.(type) {
+	case nil:
+		insecure = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		insecure = t.Clone()
+	default:
+		return transport, fmt.Errorf("%w: certificate verification can not be disabled for a %T transport",
+			ErrInsecureSkipVerifyRefused, transport)
+	}
+	if insecure.TLSClientConfig == nil {
+		insecure.TLSClientConfig = &tls.Config{}
+	}
+	insecure.TLSClientConfig.InsecureSkipVerify = true
+	return insecure, nil
+}
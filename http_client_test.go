@@ -0,0 +1,83 @@
+package paystack
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status":true}`)
+	}))
+	defer server.Close()
+
+	var calls int
+	transport := RoundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		calls++
+		return http.DefaultTransport.RoundTrip(request)
+	})
+	httpClient := &http.Client{Transport: transport}
+	tagging := func(next RoundTripperFunc) RoundTripperFunc {
+		return func(request *http.Request) (*http.Response, error) {
+			request.Header.Set("X-Tag", "1")
+			return next(request)
+		}
+	}
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL), WithHTTPClient(httpClient),
+		WithMiddleware(tagging))
+	if _, err := client.Transactions.Verify("ref"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Customers.All(); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected every client to use the provided http client, got %d calls", calls)
+	}
+	if _, ok := httpClient.Transport.(RoundTripperFunc); !ok || httpClient.Transport == nil {
+		t.Fatal("expected the provided http client not to be modified")
+	}
+}
+
+func TestWithTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status":true}`)
+	}))
+	defer server.Close()
+
+	var fromClient, fromTransport int
+	httpClient := &http.Client{Transport: RoundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		fromClient++
+		return http.DefaultTransport.RoundTrip(request)
+	})}
+	transport := RoundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		fromTransport++
+		return http.DefaultTransport.RoundTrip(request)
+	})
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL), WithTransport(transport),
+		WithHTTPClient(httpClient))
+	if _, err := client.Transactions.Verify("ref"); err != nil {
+		t.Fatal(err)
+	}
+	if fromTransport != 1 || fromClient != 0 {
+		t.Fatalf("expected the transport to take precedence, got %d and %d calls", fromTransport, fromClient)
+	}
+}
+
+func TestInsecureTransportDoesNotModifyTransport(t *testing.T) {
+	transport := &http.Transport{TLSClientConfig: &tls.Config{}}
+	rt, err := insecureTransport(transport, "https://localhost:8443")
+	if err != nil {
+		t.Fatal(err)
+	}
+	insecure := rt.(*http.Transport)
+	if !insecure.TLSClientConfig.InsecureSkipVerify {
+		t.Fatal("expected certificate verification to be skipped")
+	}
+	if transport.TLSClientConfig.InsecureSkipVerify {
+		t.Fatal("expected the provided transport not to be modified")
+	}
+}
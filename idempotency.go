@@ -0,0 +1,74 @@
+package paystack
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+)
+
+// IdempotencyKeyHeader is the header the idempotency key of a request is sent in
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// WithIdempotencyKey lets you set the idempotency key of a mutating call e.g.
+// TransactionClient.InitializeContext, TransferClient.InitiateContext or ChargeClient.CreateContext. The
+// key is sent in the IdempotencyKeyHeader header, so retrying a request with the same key, e.g. after a
+// timeout, does not charge or pay twice. Use a key derived from your own records e.g. the id of an order,
+// so that it is the same across retries.
+//
+// It is a CallOption, so it is applied to the calls made with a context created with WithCallOptions. The
+// key is only sent with the requests that are not GET requests, and since every such call made with the
+// context would share it, create a context for each mutating call.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	ctx = p.WithCallOptions(ctx, p.WithIdempotencyKey("payout-"+payout.Id))
+//	resp, err := client.Transfers.InitiateContext(ctx, "balance", 500000, "RCP_gx2wn530m0i3w3m")
+func WithIdempotencyKey(key string) CallOption {
+	return func(options *callOptions) {
+		options.idempotencyKey = key
+	}
+}
+
+// WithIdempotencyKeys lets you create an APIClient that generates an idempotency key for every call that
+// is not a GET request and has no key set with WithIdempotencyKey. The generated key is reused when the
+// client resends the request of a call, e.g. with the secondary key during a key rotation or after a rate
+// limit, so a call is never processed twice by paystack.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"), p.WithIdempotencyKeys())
+func WithIdempotencyKeys() ClientOptions {
+	return func(client *APIClient) {
+		client.autoIdempotencyKeys = true
+	}
+}
+
+// idempotencyKey returns the idempotency key of a call made with ctx, i.e. the key set with
+// WithIdempotencyKey. A key is generated for calls without one if WithIdempotencyKeys is provided. GET
+// requests have no idempotency key.
+func (a *baseAPIClient) idempotencyKey(ctx context.Context, method string) string {
+	if method == http.MethodGet {
+		return ""
+	}
+	if key := callOptionsFrom(ctx).idempotencyKey; key != "" {
+		return key
+	}
+	if a.autoIdempotencyKeys {
+		return generateIdempotencyKey()
+	}
+	return ""
+}
+
+func generateIdempotencyKey() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
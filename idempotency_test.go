@@ -0,0 +1,84 @@
+package paystack
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestIdempotencyKeys(t *testing.T) {
+	var key, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key = r.Header.Get(IdempotencyKeyHeader)
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		w.Write([]byte(`{"status":true,"message":"Transfer has been queued","data":{}}`))
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+	ctx := WithCallOptions(context.Background(), WithIdempotencyKey("payout-1"))
+	if _, err := client.Transfers.InitiateContext(ctx, "balance", 500000, "RCP_1"); err != nil {
+		t.Fatal(err)
+	}
+	if key != "payout-1" {
+		t.Fatalf("expected the idempotency key to be sent, got %q", key)
+	}
+	if strings.Contains(body, "idempotency") || strings.Contains(body, "payout-1") {
+		t.Fatalf("expected the idempotency key not to be sent in the payload, got %s", body)
+	}
+	if _, err := client.Do(ctx, http.MethodGet, "/transfer/TRF_1", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if key != "" {
+		t.Fatalf("expected no idempotency key for a GET request, got %q", key)
+	}
+	if _, err := client.Transfers.Initiate("balance", 500000, "RCP_1"); err != nil {
+		t.Fatal(err)
+	}
+	if key != "" {
+		t.Fatalf("expected no idempotency key, got %q", key)
+	}
+
+	client = NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL), WithIdempotencyKeys())
+	if _, err := client.Transfers.Initiate("balance", 500000, "RCP_1"); err != nil {
+		t.Fatal(err)
+	}
+	if len(key) != 32 {
+		t.Fatalf("expected an idempotency key to be generated, got %q", key)
+	}
+	if _, err := client.Transfers.FetchOne("TRF_1"); err != nil {
+		t.Fatal(err)
+	}
+	if key != "" {
+		t.Fatalf("expected no idempotency key for a GET request, got %q", key)
+	}
+}
+
+func TestWithIdempotencyKeyIsNotInPayload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":true,"data":{}}`))
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	var keys []string
+	recordKeys := func(m map[string]interface{}) map[string]interface{} {
+		for key := range m {
+			keys = append(keys, key)
+		}
+		return m
+	}
+	ctx := WithCallOptions(context.Background(), WithIdempotencyKey("payout-1"))
+	if _, err := client.Transfers.InitiateContext(ctx, "balance", 500000, "RCP_1", recordKeys); err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(keys)
+	if strings.Join(keys, ",") != "amount,recipient,source" {
+		t.Fatalf("expected optional parameters to only see the payload, got %q", keys)
+	}
+}
@@ -0,0 +1,300 @@
+package paystack
+
+import (
+	"context"
+	"time"
+)
+
+// The interfaces in this file are implemented by the clients of an APIClient. Depend on them instead of
+// the concrete clients to replace the clients with mocks e.g. generated with gomock or moq in your tests.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	type CheckoutService struct {
+//		transactions p.TransactionsAPI
+//	}
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	service := CheckoutService{transactions: client.Transactions}
+
+// TransactionsAPI is the interface implemented by *TransactionClient
+type TransactionsAPI interface {
+	Initialize(amount int, email string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	InitializeContext(ctx context.Context, amount int, email string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	Verify(reference string) (*Response, error)
+	VerifyContext(ctx context.Context, reference string) (*Response, error)
+	All(queries ...Query) (*Response, error)
+	FetchOne(id string) (*Response, error)
+	FetchByReference(ctx context.Context, reference string) (*Response, error)
+	ChargeAuthorization(amount int, email string, authorizationCode string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	Timeline(idOrReference string) (*Response, error)
+	Total(queries ...Query) (*Response, error)
+	Export(queries ...Query) (*Response, error)
+	PartialDebit(authorizationCode string, currency string, amount string, email string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	VerifyMany(ctx context.Context, references []string, options ParallelOptions) ([]*Response, error)
+	InitializeWithSplit(amount int, email string, split DynamicSplit, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	ForSubaccount(ctx context.Context, subaccountCode string, queries ...Query) (*Response, error)
+	VerifyUncached(reference string) (*Response, error)
+}
+
+// TransactionSplitsAPI is the interface implemented by *TransactionSplitClient
+type TransactionSplitsAPI interface {
+	Create(name string, transactionSplitType string, currency string, subaccounts interface{}, bearerType string, bearerSubaccount string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	All(queries ...Query) (*Response, error)
+	FetchOne(id string) (*Response, error)
+	Update(id string, name string, active bool, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	Add(id string, subAccount string, share int) (*Response, error)
+	Remove(id string, subAccount string) (*Response, error)
+}
+
+// TerminalsAPI is the interface implemented by *TerminalClient
+type TerminalsAPI interface {
+	SendEvent(terminalId string, eventType TerminalEvent, action string, data interface{}) (*Response, error)
+	EventStatus(terminalId string, eventId string) (*Response, error)
+	PrintReceipt(ctx context.Context, terminalId string, transactionId string) (*Response, error)
+	TerminalStatus(terminalId string) (*Response, error)
+	All(queries ...Query) (*Response, error)
+	FetchOne(terminalId string) (*Response, error)
+	Update(terminalId string, name string, address string) (*Response, error)
+	Commission(serialNumber string) (*Response, error)
+	Decommission(serialNumber string) (*Response, error)
+	PushPaymentRequest(terminalId string, paymentRequestIdOrCode string) (TerminalInvoice, error)
+}
+
+// CustomersAPI is the interface implemented by *CustomerClient
+type CustomersAPI interface {
+	Create(email string, firstName string, lastName string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	All(queries ...Query) (*Response, error)
+	FetchOne(emailOrCode string) (*Response, error)
+	Update(code string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	Upsert(ctx context.Context, email string, firstName string, lastName string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, bool, error)
+	Validate(code string, firstName string, lastName string, identificationType string, value string, country string, bvn string, bankCode string, accountNumber string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	Flag(emailOrCode string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	Deactivate(authorizationCode string) (*Response, error)
+	AppendNote(ctx context.Context, code string, note string) ([]CustomerNote, error)
+}
+
+// DedicatedVirtualAccountsAPI is the interface implemented by *DedicatedVirtualAccountClient
+type DedicatedVirtualAccountsAPI interface {
+	Create(customerIdOrCode string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	Assign(email string, firstName string, lastName string, phone string, preferredBank string, country string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	All(queries ...Query) (*Response, error)
+	FetchOne(dedicatedAccountId string) (*Response, error)
+	Requery(queries ...Query) (*Response, error)
+	Deactivate(id string) (*Response, error)
+	Split(customerIdOrCode string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	RemoveSplit(accountNumber string) (*Response, error)
+	BankProviders() (*Response, error)
+}
+
+// ApplePayAPI is the interface implemented by *ApplePayClient
+type ApplePayAPI interface {
+	Register(domainName string) (*Response, error)
+	All(queries ...Query) (*Response, error)
+	Unregister(domainName string) (*Response, error)
+	RegisterMany(ctx context.Context, domainNames []string, options ParallelOptions) ([]*Response, error)
+}
+
+// SubAccountsAPI is the interface implemented by *SubAccountClient
+type SubAccountsAPI interface {
+	Create(businessName string, settlementBank string, accountNumber string, percentageCharge float32, description string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	All(queries ...Query) (*Response, error)
+	FetchOne(idOrCode string) (*Response, error)
+	Update(idOrCode string, businessName string, settlementBank string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+}
+
+// PlansAPI is the interface implemented by *PlanClient
+type PlansAPI interface {
+	Create(name string, amount int, interval string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	All(queries ...Query) (*Response, error)
+	FetchOne(idOrCode string) (*Response, error)
+	Update(idOrCode string, name string, amount int, interval string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+}
+
+// SubscriptionsAPI is the interface implemented by *SubscriptionClient
+type SubscriptionsAPI interface {
+	Create(customer string, plan string, authorization string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	All(queries ...Query) (*Response, error)
+	FetchOne(idOrCode string) (*Response, error)
+	Enable(code string, token string) (*Response, error)
+	Disable(code string, token string) (*Response, error)
+	GenerateLink(code string) (*Response, error)
+	SendLink(code string) (*Response, error)
+	EnsureActive(ctx context.Context, customer string, plan string, authorization string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, bool, error)
+	SkipNextBilling(ctx context.Context, code string) (*Response, error)
+}
+
+// ProductsAPI is the interface implemented by *ProductClient
+type ProductsAPI interface {
+	Create(name string, description string, price int, currency string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	All(queries ...Query) (*Response, error)
+	FetchOne(id string) (*Response, error)
+	Update(id string, name string, description string, price int, currency string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	Delete(ctx context.Context, id string) (*Response, error)
+	Archive(ctx context.Context, id string) (*Response, error)
+}
+
+// PaymentPagesAPI is the interface implemented by *PaymentPageClient
+type PaymentPagesAPI interface {
+	Create(name string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	All(queries ...Query) (*Response, error)
+	FetchOne(idOrSlug string) (*Response, error)
+	Update(idOrSlug string, name string, description string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	CheckSlug(slug string) (*Response, error)
+	AddProducts(id string, products []string) (*Response, error)
+	SuggestSlug(ctx context.Context, name string) (string, error)
+}
+
+// PaymentRequestsAPI is the interface implemented by *PaymentRequestClient
+type PaymentRequestsAPI interface {
+	Create(customerIdOrCode string, amount int, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	All(queries ...Query) (*Response, error)
+	FetchOne(idOrCode string) (*Response, error)
+	Verify(code string) (*Response, error)
+	SendNotification(code string) (*Response, error)
+	Total() (*Response, error)
+	Finalize(code string, sendNotification bool) (*Response, error)
+	Update(idOrCode string, customerIdOrCode string, amount int, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	Archive(idOrCode string) (*Response, error)
+	CreateDraft(customerIdOrCode string, lineItems []LineItem, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	UpdateLineItems(idOrCode string, lineItems []LineItem) (*Response, error)
+	FinalizeAndNotify(code string) (*Response, error)
+}
+
+// SettlementsAPI is the interface implemented by *SettlementClient
+type SettlementsAPI interface {
+	All(queries ...Query) (*Response, error)
+	AllTransactions(settlementId string, queries ...Query) (*Response, error)
+}
+
+// TransferRecipientsAPI is the interface implemented by *TransferRecipientClient
+type TransferRecipientsAPI interface {
+	Create(recipientType string, name string, accountNumber string, bankCode string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	CreateMobileMoney(currency string, name string, provider string, phone string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	CreateBasa(name string, accountNumber string, bankCode string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	CreateAuthorization(name string, email string, authorizationCode string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	BulkCreate(batch interface{}) (*Response, error)
+	All(queries ...Query) (*Response, error)
+	FetchOne(idOrCode string) (*Response, error)
+	Update(idOrCode string, name string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	Delete(idOrCode string) (*Response, error)
+}
+
+// TransfersAPI is the interface implemented by *TransferClient
+type TransfersAPI interface {
+	Initiate(source string, amount int, recipient string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	InitiateContext(ctx context.Context, source string, amount int, recipient string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	Finalize(transferCode string, otp string) (*Response, error)
+	BulkInitiate(source string, transfers interface{}) (*Response, error)
+	All(queries ...Query) (*Response, error)
+	FetchOne(idOrCode string) (*Response, error)
+	Verify(reference string) (*Response, error)
+	FeeFor(amount int, currency string) (int, error)
+	PreviewFee(amount int, currency string) (TransferFeePreview, error)
+}
+
+// TransferControlAPI is the interface implemented by *TransferControlClient
+type TransferControlAPI interface {
+	Balance() (*Response, error)
+	BalanceLedger() (*Response, error)
+	ResendOTP(transferCode string, reason string) (*Response, error)
+	DisableOTP() (*Response, error)
+	FinalizeDisableOTP(otp string) (*Response, error)
+	EnableOTP() (*Response, error)
+}
+
+// BulkChargesAPI is the interface implemented by *BulkChargeClient
+type BulkChargesAPI interface {
+	Initiate(charges interface{}) (*Response, error)
+	All(queries ...Query) (*Response, error)
+	FetchOne(idOrCode string) (*Response, error)
+	Charges(idOrCode string, queries ...Query) (*Response, error)
+	Pause(idOrCode string) (*Response, error)
+	Resume(idOrCode string) (*Response, error)
+}
+
+// IntegrationAPI is the interface implemented by *IntegrationClient
+type IntegrationAPI interface {
+	Timeout() (*Response, error)
+	UpdateTimeout(timeout int) (*Response, error)
+}
+
+// ChargesAPI is the interface implemented by *ChargeClient
+type ChargesAPI interface {
+	Create(email string, amount string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	CreateContext(ctx context.Context, email string, amount string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	SubmitPin(pin string, reference string) (*Response, error)
+	SubmitOTP(otp string, reference string) (*Response, error)
+	SubmitPhone(phone string, reference string) (*Response, error)
+	SubmitBirthday(birthday string, reference string) (*Response, error)
+	SubmitAddress(address string, reference string, city string, state string, zipCode string) (*Response, error)
+	PendingCharge(reference string) (*Response, error)
+	AwaitFinalStatus(ctx context.Context, reference string, minWait time.Duration, maxWait time.Duration) (*Response, error)
+	CreateMobileMoney(email string, amount string, currency string, phone string, provider MobileMoneyProvider, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+}
+
+// DisputesAPI is the interface implemented by *DisputeClient
+type DisputesAPI interface {
+	All(queries ...Query) (*Response, error)
+	FetchOne(id string) (*Response, error)
+	AllTransactionDisputes(transactionId string) (*Response, error)
+	Update(id string, referenceAmount int, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	AddEvidence(id string, customerEmail string, customerName string, customerPhone string, serviceDetails string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	AddEvidenceFromTemplate(id string, customerEmail string, customerName string, customerPhone string, evidence DisputeEvidence, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	UploadURL(id string, queries ...Query) (*Response, error)
+	Resolve(id string, resolution string, message string, refundAmount int, uploadedFilename string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	Export(queries ...Query) (*Response, error)
+	DueWithin(ctx context.Context, within time.Duration, queries ...Query) ([]DisputeDeadline, error)
+	Thread(ctx context.Context, id string) ([]DisputeThreadEntry, error)
+	ExportThread(ctx context.Context, id string, format string) (string, error)
+}
+
+// RefundsAPI is the interface implemented by *RefundClient
+type RefundsAPI interface {
+	Create(transaction string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	All(queries ...Query) (*Response, error)
+	FetchOne(reference string) (*Response, error)
+}
+
+// VerificationAPI is the interface implemented by *VerificationClient
+type VerificationAPI interface {
+	ResolveAccount(queries ...Query) (*Response, error)
+	EnsureNuban(accountNumber string, bankCode string, expectedName string, matcher *NameMatcher) (NubanCheck, error)
+	ValidateAccount(accountName string, accountNumber string, accountType string, bankCode string, countryCode string, documentType string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error)
+	ResolveBIN(bin string) (*Response, error)
+}
+
+// MiscellaneousAPI is the interface implemented by *MiscellaneousClient
+type MiscellaneousAPI interface {
+	Banks(queries ...Query) (*Response, error)
+	Countries() (*Response, error)
+	States(queries ...Query) (*Response, error)
+}
+
+var (
+	_ TransactionsAPI             = (*TransactionClient)(nil)
+	_ TransactionSplitsAPI        = (*TransactionSplitClient)(nil)
+	_ TerminalsAPI                = (*TerminalClient)(nil)
+	_ CustomersAPI                = (*CustomerClient)(nil)
+	_ DedicatedVirtualAccountsAPI = (*DedicatedVirtualAccountClient)(nil)
+	_ ApplePayAPI                 = (*ApplePayClient)(nil)
+	_ SubAccountsAPI              = (*SubAccountClient)(nil)
+	_ PlansAPI                    = (*PlanClient)(nil)
+	_ SubscriptionsAPI            = (*SubscriptionClient)(nil)
+	_ ProductsAPI                 = (*ProductClient)(nil)
+	_ PaymentPagesAPI             = (*PaymentPageClient)(nil)
+	_ PaymentRequestsAPI          = (*PaymentRequestClient)(nil)
+	_ SettlementsAPI              = (*SettlementClient)(nil)
+	_ TransferRecipientsAPI       = (*TransferRecipientClient)(nil)
+	_ TransfersAPI                = (*TransferClient)(nil)
+	_ TransferControlAPI          = (*TransferControlClient)(nil)
+	_ BulkChargesAPI              = (*BulkChargeClient)(nil)
+	_ IntegrationAPI              = (*IntegrationClient)(nil)
+	_ ChargesAPI                  = (*ChargeClient)(nil)
+	_ DisputesAPI                 = (*DisputeClient)(nil)
+	_ RefundsAPI                  = (*RefundClient)(nil)
+	_ VerificationAPI             = (*VerificationClient)(nil)
+	_ MiscellaneousAPI            = (*MiscellaneousClient)(nil)
+)
@@ -0,0 +1,51 @@
+package paystack
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestInterfacesAreComplete checks that the interfaces of the clients are updated when methods are added
+// to the clients
+func TestInterfacesAreComplete(t *testing.T) {
+	base := reflect.TypeOf(&baseAPIClient{})
+	client := NewAPIClient()
+	interfaces := map[string]reflect.Type{
+		"Transactions":             reflect.TypeOf((*TransactionsAPI)(nil)).Elem(),
+		"TransactionSplits":        reflect.TypeOf((*TransactionSplitsAPI)(nil)).Elem(),
+		"Terminals":                reflect.TypeOf((*TerminalsAPI)(nil)).Elem(),
+		"Customers":                reflect.TypeOf((*CustomersAPI)(nil)).Elem(),
+		"DedicatedVirtualAccounts": reflect.TypeOf((*DedicatedVirtualAccountsAPI)(nil)).Elem(),
+		"ApplePay":                 reflect.TypeOf((*ApplePayAPI)(nil)).Elem(),
+		"SubAccounts":              reflect.TypeOf((*SubAccountsAPI)(nil)).Elem(),
+		"Plans":                    reflect.TypeOf((*PlansAPI)(nil)).Elem(),
+		"Subscriptions":            reflect.TypeOf((*SubscriptionsAPI)(nil)).Elem(),
+		"Products":                 reflect.TypeOf((*ProductsAPI)(nil)).Elem(),
+		"PaymentPages":             reflect.TypeOf((*PaymentPagesAPI)(nil)).Elem(),
+		"PaymentRequests":          reflect.TypeOf((*PaymentRequestsAPI)(nil)).Elem(),
+		"Settlements":              reflect.TypeOf((*SettlementsAPI)(nil)).Elem(),
+		"TransferRecipients":       reflect.TypeOf((*TransferRecipientsAPI)(nil)).Elem(),
+		"Transfers":                reflect.TypeOf((*TransfersAPI)(nil)).Elem(),
+		"TransferControl":          reflect.TypeOf((*TransferControlAPI)(nil)).Elem(),
+		"BulkCharges":              reflect.TypeOf((*BulkChargesAPI)(nil)).Elem(),
+		"Integration":              reflect.TypeOf((*IntegrationAPI)(nil)).Elem(),
+		"Charges":                  reflect.TypeOf((*ChargesAPI)(nil)).Elem(),
+		"Disputes":                 reflect.TypeOf((*DisputesAPI)(nil)).Elem(),
+		"Refunds":                  reflect.TypeOf((*RefundsAPI)(nil)).Elem(),
+		"Verification":             reflect.TypeOf((*VerificationAPI)(nil)).Elem(),
+		"Miscellaneous":            reflect.TypeOf((*MiscellaneousAPI)(nil)).Elem(),
+	}
+	clientValue := reflect.ValueOf(client).Elem()
+	for field, iface := range interfaces {
+		concrete := clientValue.FieldByName(field).Type()
+		for i := 0; i < concrete.NumMethod(); i++ {
+			name := concrete.Method(i).Name
+			if _, promoted := base.MethodByName(name); promoted {
+				continue
+			}
+			if _, ok := iface.MethodByName(name); !ok {
+				t.Errorf("%s.%s is missing from %s", concrete.Elem().Name(), name, iface.Name())
+			}
+		}
+	}
+}
@@ -0,0 +1,34 @@
+// Package limits contains the limits paystack enforces on requests. They are used by the validators and
+// chunkers of the paystack package and are exported so that the limits are not scattered across your
+// code as magic numbers.
+//
+// Example
+//
+//	import "github.com/gray-adeyi/paystack/limits"
+//
+//	if len(transfers) > limits.MaxBulkTransfers {
+//		// split the transfers into batches
+//	}
+package limits
+
+const (
+	// MaxBulkTransfers is the maximum number of transfers that can be initiated in a single call of
+	// TransferClient.BulkInitiate
+	MaxBulkTransfers = 100
+
+	// MaxBulkRecipients is the maximum number of transfer recipients that can be created in a single call
+	// of TransferRecipientClient.BulkCreate
+	MaxBulkRecipients = 100
+
+	// MaxPerPage is the maximum number of records paystack returns per page of a list endpoint
+	MaxPerPage = 100
+
+	// MaxReferenceLength is the maximum length of the reference of a transaction, charge or transfer
+	MaxReferenceLength = 100
+
+	// MaxMetadataSize is the maximum size in bytes of the JSON encoded metadata of a resource
+	MaxMetadataSize = 10 * 1024
+
+	// MinAmount is the minimum amount in the subunit of a currency, e.g. kobo, that can be charged
+	MinAmount = 100
+)
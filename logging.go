@@ -0,0 +1,70 @@
+package paystack
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// WithLogger lets you create an APIClient that logs every request and response with logger. Requests are
+// logged at the debug level with their headers and payload, responses at the info level with their status
+// code and duration, and requests that did not receive a response at the warn level. The secret key in the
+// Authorization header is masked with RedactSecretKey and payloads are redacted with RedactJSON, so card
+// numbers, PINs and OTPs are not logged.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"), p.WithLogger(logger))
+func WithLogger(logger *slog.Logger) ClientOptions {
+	return func(client *APIClient) {
+		client.logger = logger
+	}
+}
+
+// logRequest logs a request before it is sent at the debug level
+func (a *baseAPIClient) logRequest(ctx context.Context, request *http.Request, body []byte) {
+	if a.logger == nil || !a.logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+	headers := make([]interface{}, 0, len(request.Header))
+	for key := range request.Header {
+		value := request.Header.Get(key)
+		if strings.EqualFold(key, "Authorization") {
+			value = "Bearer " + RedactSecretKey(strings.TrimPrefix(value, "Bearer "))
+		}
+		headers = append(headers, slog.String(key, value))
+	}
+	attrs := []interface{}{
+		slog.String("method", request.Method),
+		slog.String("path", request.URL.Path),
+		slog.Group("headers", headers...),
+	}
+	// compressed payloads are not logged
+	if len(body) > 0 && request.Header.Get("Content-Encoding") == "" {
+		attrs = append(attrs, slog.String("payload", string(RedactJSON(body))))
+	}
+	a.logger.DebugContext(ctx, "paystack request", attrs...)
+}
+
+// logResponse logs the outcome of a request. A statusCode of 0 means a response was not received.
+func (a *baseAPIClient) logResponse(ctx context.Context, method string, endPointPath string, duration time.Duration, statusCode int, err error) {
+	if a.logger == nil {
+		return
+	}
+	path, _, _ := strings.Cut(endPointPath, "?")
+	attrs := []interface{}{
+		slog.String("method", method),
+		slog.String("path", path),
+		slog.Duration("duration", duration),
+	}
+	if statusCode == 0 {
+		a.logger.WarnContext(ctx, "paystack request failed", append(attrs, slog.Any("error", err))...)
+		return
+	}
+	a.logger.InfoContext(ctx, "paystack response", append(attrs, slog.Int("status", statusCode))...)
+}
@@ -0,0 +1,38 @@
+package paystack
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWithLogger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":true,"message":"Charge attempted","data":{}}`))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client := NewAPIClient(WithSecretKey("sk_test_supersecret"), WithBaseUrl(server.URL), WithLogger(logger))
+	if _, err := client.Charges.Create("johndoe@example.com", "20000", WithOptionalParameter("card", map[string]interface{}{
+		"number": "4084084084084081", "cvv": "408",
+	})); err != nil {
+		t.Fatal(err)
+	}
+
+	output := logs.String()
+	for _, leaked := range []string{"supersecret", "4084084084084081", `"cvv":"408"`} {
+		if strings.Contains(output, leaked) {
+			t.Errorf("expected %q to be masked in the logs, got %s", leaked, output)
+		}
+	}
+	for _, expected := range []string{"paystack request", "paystack response", "status=200", "path=/charge", "sk_test_[REDACTED]"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected %q in the logs, got %s", expected, output)
+		}
+	}
+}
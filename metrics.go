@@ -0,0 +1,86 @@
+package paystack
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// RequestMetrics contains the details of a request sent to paystack passed to a MetricsCollector
+type RequestMetrics struct {
+	Method string
+	// Endpoint is the family of the endpoint of the request e.g. `transaction` for
+	// `/transaction/verify/<reference>`. It has a bounded number of values, which makes it suitable as a
+	// label of metrics.
+	Endpoint string
+	// Path is the path of the request without its query e.g. `/transaction/verify/<reference>`
+	Path string
+	// StatusCode is the status code of the response. It is 0 if a response was not received.
+	StatusCode int
+	Duration   time.Duration
+}
+
+// MetricsCollector is implemented by types that record the metrics of the requests sent by an APIClient,
+// e.g. to alert on the error rate of paystack from your payments service. ObserveRequest is called once for
+// every request sent, including retries, so it should not block.
+//
+// Example
+//
+//	type PrometheusCollector struct {
+//		requests *prometheus.CounterVec
+//		latency  *prometheus.HistogramVec
+//	}
+//
+//	func (c PrometheusCollector) ObserveRequest(metrics p.RequestMetrics) {
+//		status := strconv.Itoa(metrics.StatusCode)
+//		c.requests.WithLabelValues(metrics.Method, metrics.Endpoint, status).Inc()
+//		c.latency.WithLabelValues(metrics.Method, metrics.Endpoint).Observe(metrics.Duration.Seconds())
+//	}
+type MetricsCollector interface {
+	ObserveRequest(metrics RequestMetrics)
+}
+
+// MetricsCollectorFunc is an adapter that allows the use of an ordinary function as a MetricsCollector
+type MetricsCollectorFunc func(metrics RequestMetrics)
+
+// ObserveRequest calls f(metrics)
+func (f MetricsCollectorFunc) ObserveRequest(metrics RequestMetrics) {
+	f(metrics)
+}
+
+// WithMetricsCollector lets you set the MetricsCollector that records the metrics of every request of an
+// APIClient.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"),
+//		p.WithMetricsCollector(p.MetricsCollectorFunc(func(metrics p.RequestMetrics) {
+//			log.Printf("%s %s %d %s", metrics.Method, metrics.Path, metrics.StatusCode, metrics.Duration)
+//		})))
+func WithMetricsCollector(collector MetricsCollector) ClientOptions {
+	return func(client *APIClient) {
+		client.metrics = collector
+	}
+}
+
+// recordRequest records the outcome of a request in the Stats of the client, its MetricsCollector and its
+// logger. A statusCode of 0 means a response was not received, in which case err is why.
+func (a *baseAPIClient) recordRequest(ctx context.Context, method string, endPointPath string, duration time.Duration, statusCode int, err error) {
+	a.stats.record(endPointPath, duration, statusCode)
+	a.logResponse(ctx, method, endPointPath, duration, statusCode, err)
+	if a.metrics == nil {
+		return
+	}
+	path, _, _ := strings.Cut(endPointPath, "?")
+	a.callbackSafely(ctx, "metrics collector", func() {
+		a.metrics.ObserveRequest(RequestMetrics{
+			Method:     method,
+			Endpoint:   endpointFamily(endPointPath),
+			Path:       path,
+			StatusCode: statusCode,
+			Duration:   duration,
+		})
+	})
+}
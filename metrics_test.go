@@ -0,0 +1,32 @@
+package paystack
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithMetricsCollector(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"status":false,"message":"Transaction reference not found"}`))
+	}))
+	defer server.Close()
+
+	var observed []RequestMetrics
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL),
+		WithMetricsCollector(MetricsCollectorFunc(func(metrics RequestMetrics) {
+			observed = append(observed, metrics)
+		})))
+	if _, err := client.Transactions.Verify("ref"); err != nil {
+		t.Fatal(err)
+	}
+	if len(observed) != 1 {
+		t.Fatalf("expected a request to be observed, got %d", len(observed))
+	}
+	metrics := observed[0]
+	if metrics.Method != http.MethodGet || metrics.Endpoint != "transaction" ||
+		metrics.Path != "/transaction/verify/ref" || metrics.StatusCode != http.StatusBadRequest {
+		t.Fatalf("unexpected metrics %+v", metrics)
+	}
+}
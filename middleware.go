@@ -0,0 +1,52 @@
+package paystack
+
+import "net/http"
+
+// RoundTripperFunc is an adapter that allows the use of an ordinary function as an http.RoundTripper
+type RoundTripperFunc func(request *http.Request) (*http.Response, error)
+
+// RoundTrip calls f(request)
+func (f RoundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+// Middleware wraps the RoundTripperFunc that sends the requests of an APIClient. A Middleware can inspect
+// or modify a request before calling next and inspect the response next returns, e.g. for logging,
+// metrics or swapping the credentials of a request.
+type Middleware func(next RoundTripperFunc) RoundTripperFunc
+
+// WithMiddleware lets you add middlewares that every request of an APIClient passes through, across all
+// its clients. The first middleware is the outermost, i.e. it sees a request first and its response
+// last. Requests reach the middlewares after their headers are set and they are signed with the
+// RequestSigner of the client.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	logging := func(next p.RoundTripperFunc) p.RoundTripperFunc {
+//		return func(request *http.Request) (*http.Response, error) {
+//			start := time.Now()
+//			response, err := next(request)
+//			log.Printf("%s %s took %s", request.Method, request.URL.Path, time.Since(start))
+//			return response, err
+//		}
+//	}
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"), p.WithMiddleware(logging))
+func WithMiddleware(middlewares ...Middleware) ClientOptions {
+	return func(client *APIClient) {
+		client.middlewares = append(client.middlewares, middlewares...)
+	}
+}
+
+// applyMiddlewares wraps transport with middlewares, the first middleware being the outermost
+func applyMiddlewares(transport http.RoundTripper, middlewares []Middleware) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	next := RoundTripperFunc(transport.RoundTrip)
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		next = middlewares[i](next)
+	}
+	return next
+}
@@ -0,0 +1,48 @@
+package paystack
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestWithMiddleware(t *testing.T) {
+	var secretKey string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		secretKey = r.Header.Get("Authorization")
+		w.Write([]byte(`{"status":true,"message":"Verification successful","data":{}}`))
+	}))
+	defer server.Close()
+
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next RoundTripperFunc) RoundTripperFunc {
+			return func(request *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" request")
+				response, err := next(request)
+				calls = append(calls, name+" response")
+				return response, err
+			}
+		}
+	}
+	swapKey := func(next RoundTripperFunc) RoundTripperFunc {
+		return func(request *http.Request) (*http.Response, error) {
+			request.Header.Set("Authorization", "Bearer sk_test_swapped")
+			return next(request)
+		}
+	}
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL),
+		WithMiddleware(record("outer"), record("inner")), WithMiddleware(swapKey))
+
+	if _, err := client.Transactions.Verify("ref"); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"outer request", "inner request", "inner response", "outer response"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Fatalf("expected the middlewares to be called in order %v, got %v", expected, calls)
+	}
+	if secretKey != "Bearer sk_test_swapped" {
+		t.Fatalf("expected the middleware to modify the request, got %q", secretKey)
+	}
+}
@@ -0,0 +1,68 @@
+package paystack
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MobileMoneyProvider is a mobile money provider supported by paystack
+type MobileMoneyProvider = string
+
+const (
+	MobileMoneyMTN        MobileMoneyProvider = "mtn"
+	MobileMoneyVodafone   MobileMoneyProvider = "vod"
+	MobileMoneyAirtelTigo MobileMoneyProvider = "atl"
+	MobileMoneyMPesa      MobileMoneyProvider = "mpesa"
+	MobileMoneyOrange     MobileMoneyProvider = "orange"
+	MobileMoneyWave       MobileMoneyProvider = "wave"
+)
+
+// ErrInvalidMobileMoneyProvider is returned when a mobile money provider is not supported in a currency
+var ErrInvalidMobileMoneyProvider = errors.New("mobile money provider is not supported in currency")
+
+// mobileMoneyProviders are the mobile money providers supported per currency
+var mobileMoneyProviders = map[string][]MobileMoneyProvider{
+	"GHS": {MobileMoneyMTN, MobileMoneyVodafone, MobileMoneyAirtelTigo},
+	"KES": {MobileMoneyMPesa},
+	"XOF": {MobileMoneyMTN, MobileMoneyOrange, MobileMoneyWave},
+}
+
+// ValidateMobileMoneyProvider lets you check that provider is supported in currency e.g. `mtn` in GHS.
+// provider is compared without regard to case. An error wrapping ErrInvalidMobileMoneyProvider is returned
+// for an invalid combination.
+func ValidateMobileMoneyProvider(currency string, provider MobileMoneyProvider) error {
+	for _, supported := range mobileMoneyProviders[currency] {
+		if strings.EqualFold(provider, supported) {
+			return nil
+		}
+	}
+	supported := append([]string(nil), mobileMoneyProviders[currency]...)
+	sort.Strings(supported)
+	return fmt.Errorf("%w %s: %q (supported providers: %s)", ErrInvalidMobileMoneyProvider, currency, provider,
+		strings.Join(supported, ", "))
+}
+
+// CreateMobileMoney lets you charge a customer's mobile money wallet. The provider is validated against
+// currency with ValidateMobileMoneyProvider before the charge is created with ChargeClient.Create.
+//
+// Example:
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	chargeClient := p.NewChargeClient(p.WithSecretKey("<paystack-secret-key>"))
+//	resp, err := chargeClient.CreateMobileMoney("johndoe@example.com", "10000", "GHS", "0551234987", p.MobileMoneyMTN)
+func (c *ChargeClient) CreateMobileMoney(email string, amount string, currency string, phone string,
+	provider MobileMoneyProvider, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error) {
+	if err := ValidateMobileMoneyProvider(currency, provider); err != nil {
+		return nil, err
+	}
+	optionalPayloadParameters = append(optionalPayloadParameters,
+		WithOptionalParameter("currency", currency),
+		WithOptionalParameter("mobile_money", map[string]interface{}{
+			"phone":    phone,
+			"provider": strings.ToLower(provider),
+		}))
+	return c.Create(email, amount, optionalPayloadParameters...)
+}
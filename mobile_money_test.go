@@ -0,0 +1,28 @@
+package paystack
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateMobileMoneyProvider(t *testing.T) {
+	for _, c := range []struct {
+		currency string
+		provider MobileMoneyProvider
+		valid    bool
+	}{
+		{"GHS", MobileMoneyMTN, true},
+		{"GHS", "VOD", true},
+		{"KES", MobileMoneyMPesa, true},
+		{"KES", MobileMoneyMTN, false},
+		{"NGN", MobileMoneyMTN, false},
+	} {
+		err := ValidateMobileMoneyProvider(c.currency, c.provider)
+		if c.valid && err != nil {
+			t.Errorf("%s %s: expected no error, got %v", c.currency, c.provider, err)
+		}
+		if !c.valid && !errors.Is(err, ErrInvalidMobileMoneyProvider) {
+			t.Errorf("%s %s: expected %v, got %v", c.currency, c.provider, ErrInvalidMobileMoneyProvider, err)
+		}
+	}
+}
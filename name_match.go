@@ -0,0 +1,155 @@
+package paystack
+
+import (
+	"strings"
+	"unicode"
+)
+
+// DefaultNameMatchThreshold is the minimum score at which two names are considered a match by NameMatch
+const DefaultNameMatchThreshold = 0.75
+
+// honorifics are tokens ignored when matching names
+var honorifics = map[string]bool{
+	"mr": true, "mrs": true, "ms": true, "miss": true, "dr": true, "prof": true,
+	"chief": true, "alhaji": true, "alhaja": true, "engr": true, "pastor": true,
+}
+
+// NameMatcher scores how similar two person names are. It is intended for comparing the account name a
+// customer provides with the account name a bank resolves, e.g. before initiating a payout. Names are
+// compared token by token so that the order of the names does not matter, honorifics are ignored,
+// initials match the names they abbreviate and small spelling differences are tolerated.
+type NameMatcher struct {
+	// Threshold is the minimum score at which two names are considered a match
+	Threshold float64
+}
+
+// Match returns the similarity score of provided and resolved, between 0 and 1, and whether the score
+// meets the threshold of the NameMatcher. Since a single shared name, e.g. a surname, does not identify a
+// person, names are only considered a match when at least two of their tokens match, or when both names
+// are a single token. Since initials do not identify a person either, at least one of the matching tokens
+// must be a full name rather than an initial e.g. `J S` does not match `John Smith`. Otherwise the score
+// is that of the matching tokens against all the tokens of the longer name, and the names are not
+// considered a match.
+func (m NameMatcher) Match(provided string, resolved string) (float64, bool) {
+	providedTokens := nameTokens(provided)
+	resolvedTokens := nameTokens(resolved)
+	if len(providedTokens) == 0 || len(resolvedTokens) == 0 {
+		return 0, false
+	}
+
+	shorter, longer := providedTokens, resolvedTokens
+	if len(shorter) > len(longer) {
+		shorter, longer = longer, shorter
+	}
+	used := make([]bool, len(longer))
+	total := 0.0
+	matched, fullyMatched := 0, 0
+	for _, token := range shorter {
+		best, bestIndex := 0.0, -1
+		for i, candidate := range longer {
+			if used[i] {
+				continue
+			}
+			if score := tokenSimilarity(token, candidate); score > best {
+				best, bestIndex = score, i
+			}
+		}
+		if bestIndex >= 0 {
+			used[bestIndex] = true
+			total += best
+			matched++
+			if len(token) > 1 && len(longer[bestIndex]) > 1 {
+				fullyMatched++
+			}
+		}
+	}
+	// a single matching token only identifies a person whose name is a single token on both sides
+	if required := minInt(2, len(longer)); matched < required || fullyMatched == 0 {
+		return total / float64(len(longer)), false
+	}
+	// names with extra tokens e.g. a middle name are penalized less than names with mismatched tokens
+	score := 2 * total / float64(len(shorter)+len(longer))
+	coverage := total / float64(len(shorter))
+	if coverage > score {
+		score = (score + coverage) / 2
+	}
+	return score, score >= m.Threshold
+}
+
+// NameMatch returns the similarity score of provided and resolved, between 0 and 1, and whether they
+// are considered a match using DefaultNameMatchThreshold. Use a NameMatcher to configure the threshold.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	score, ok := p.NameMatch("Gbenga Adeyi", "ADEYI GBENGA O.")
+func NameMatch(provided string, resolved string) (float64, bool) {
+	return NameMatcher{Threshold: DefaultNameMatchThreshold}.Match(provided, resolved)
+}
+
+func nameTokens(name string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	tokens := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if !honorifics[field] {
+			tokens = append(tokens, field)
+		}
+	}
+	return tokens
+}
+
+func tokenSimilarity(a string, b string) float64 {
+	if a == b {
+		return 1
+	}
+	// an initial matches the name it abbreviates
+	if len(a) == 1 || len(b) == 1 {
+		if a[0] == b[0] {
+			return 0.8
+		}
+		return 0
+	}
+	ra, rb := []rune(a), []rune(b)
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	similarity := 1 - float64(levenshtein(ra, rb))/float64(longest)
+	if similarity < 0.6 {
+		return 0
+	}
+	return similarity
+}
+
+func levenshtein(a []rune, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func minInt(values ...int) int {
+	result := values[0]
+	for _, value := range values[1:] {
+		if value < result {
+			result = value
+		}
+	}
+	return result
+}
@@ -0,0 +1,37 @@
+package paystack
+
+import "testing"
+
+func TestNameMatch(t *testing.T) {
+	tests := []struct {
+		provided string
+		resolved string
+		ok       bool
+	}{
+		{"Gbenga Adeyi", "GBENGA ADEYI", true},
+		{"Gbenga Adeyi", "ADEYI GBENGA", true},
+		{"Gbenga Adeyi", "ADEYI GBENGA OLUWASEUN", true},
+		{"Mr. Gbenga Adeyi", "ADEYI, G.", true},
+		{"Gbenga Adeyi", "Gbnega Adeyi", true},
+		{"Gbenga Adeyi", "Chioma Okafor", false},
+		{"Gbenga Adeyi", "Gbenga Okafor", false},
+		{"Gbenga", "Gbenga Okafor", false},
+		{"Okafor", "Gbenga Okafor", false},
+		{"Gbenga Okafor", "OKAFOR", false},
+		{"Mr. Okafor", "Chief Gbenga Okafor", false},
+		{"Gbenga Adeyi Okafor", "Chioma Ngozi Okafor", false},
+		{"Gbenga", "GBENGA", true},
+		{"J S", "John Smith", false},
+		{"A B", "Abubakar Bello", false},
+		{"John Smith", "J. S.", false},
+		{"J. Smith", "John Smith", true},
+		{"G", "G", false},
+		{"", "Gbenga Adeyi", false},
+	}
+	for _, test := range tests {
+		score, ok := NameMatch(test.provided, test.resolved)
+		if ok != test.ok {
+			t.Errorf("NameMatch(%q, %q) = %.2f, %v, expected %v", test.provided, test.resolved, score, ok, test.ok)
+		}
+	}
+}
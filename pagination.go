@@ -0,0 +1,57 @@
+package paystack
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/gray-adeyi/paystack/limits"
+)
+
+// defaultPerPage is the number of records retrieved per page by helpers that iterate over all the pages
+// of a list endpoint
+const defaultPerPage = limits.MaxPerPage
+
+// paginationMeta is the `meta` of the responses of paystack's list endpoints
+type paginationMeta struct {
+	Total     json.Number `json:"total"`
+	Page      json.Number `json:"page"`
+	PageCount json.Number `json:"pageCount"`
+}
+
+// forEachPage calls fn with the response of every page of a list endpoint until there are no more pages
+// or fn returns false or an error. A page paystack failed, or whose `meta` has no valid `pageCount`, is
+// returned as an error rather than treated as the last page, so that callers never act on a partial list.
+func forEachPage(list ListFunc, fn func(resp *Response) (bool, error), queries ...Query) error {
+	for page := 1; ; page++ {
+		pageQueries := append([]Query{
+			WithQuery("perPage", strconv.Itoa(defaultPerPage)),
+			WithQuery("page", strconv.Itoa(page)),
+		}, queries...)
+		resp, err := list(pageQueries...)
+		if err != nil {
+			return err
+		}
+		if err = resp.AsError(); err != nil {
+			return err
+		}
+		next, err := fn(resp)
+		if err != nil || !next {
+			return err
+		}
+		var body struct {
+			Meta paginationMeta `json:"meta"`
+		}
+		if err = resp.Decode(&body); err != nil {
+			return err
+		}
+		pageCount, err := body.Meta.PageCount.Int64()
+		if err != nil {
+			return fmt.Errorf("unable to list page %d of %s: invalid pageCount %q", page, resp.Endpoint(),
+				body.Meta.PageCount)
+		}
+		if int64(page) >= pageCount {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package paystack
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestForEachPage(t *testing.T) {
+	tests := []struct {
+		name      string
+		responses []*Response
+		pages     int
+		wantErr   error
+	}{
+		{
+			name: "all pages",
+			responses: []*Response{
+				{StatusCode: http.StatusOK, Data: []byte(`{"status":true,"data":[],"meta":{"page":1,"pageCount":2}}`)},
+				{StatusCode: http.StatusOK, Data: []byte(`{"status":true,"data":[],"meta":{"page":2,"pageCount":2}}`)},
+			},
+			pages: 2,
+		},
+		{
+			name: "failed page",
+			responses: []*Response{
+				{StatusCode: http.StatusOK, Data: []byte(`{"status":true,"data":[],"meta":{"page":1,"pageCount":2}}`)},
+				{StatusCode: http.StatusTooManyRequests, Data: []byte(`{"status":false,"message":"Too many requests"}`)},
+			},
+			pages:   1,
+			wantErr: ErrRateLimited,
+		},
+		{
+			name: "false status",
+			responses: []*Response{
+				{StatusCode: http.StatusOK, Data: []byte(`{"status":false,"message":"Invalid key"}`)},
+			},
+			wantErr: ErrInvalidKey,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests, pages int
+			list := func(queries ...Query) (*Response, error) {
+				requests++
+				return tt.responses[requests-1], nil
+			}
+			err := forEachPage(list, func(resp *Response) (bool, error) {
+				pages++
+				return true, nil
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if pages != tt.pages {
+				t.Fatalf("expected %d pages to be handled, got %d", tt.pages, pages)
+			}
+		})
+	}
+
+	t.Run("missing page count", func(t *testing.T) {
+		list := func(queries ...Query) (*Response, error) {
+			return &Response{StatusCode: http.StatusOK, Data: []byte(`{"status":true,"data":[]}`)}, nil
+		}
+		err := forEachPage(list, func(resp *Response) (bool, error) { return true, nil })
+		if err == nil {
+			t.Fatal("expected a page without a page count to be an error")
+		}
+	})
+}
@@ -0,0 +1,97 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+)
+
+// PanicPolicy specifies what the subsystems that call your functions, e.g. a WebhookHandler or a Syncer, do
+// when one of your functions panics
+type PanicPolicy int
+
+const (
+	// PanicRecover recovers from the panic and reports it as a *PanicError, so that the subsystem keeps
+	// running. It is the default PanicPolicy.
+	PanicRecover PanicPolicy = iota
+	// PanicCrash lets the panic propagate, crashing the program unless it is recovered elsewhere
+	PanicCrash
+)
+
+// PanicError is the error a panic of one of your functions is converted into with PanicRecover
+type PanicError struct {
+	// Callback describes the function that panicked e.g. `webhook handler for charge.success`
+	Callback string
+	// Value is the value the function panicked with
+	Value interface{}
+	// Stack is the stack trace of the goroutine that panicked
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic in %s: %v", e.Callback, e.Value)
+}
+
+// Unwrap returns the value the function panicked with if it is an error
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// callSafely calls fn, applying policy if fn panics. callback describes fn in the *PanicError.
+func callSafely(callback string, policy PanicPolicy, fn func() error) (err error) {
+	if policy == PanicCrash {
+		return fn()
+	}
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = &PanicError{Callback: callback, Value: recovered, Stack: debug.Stack()}
+		}
+	}()
+	return fn()
+}
+
+// callbackSafely calls fn, a function of yours that can not report an error e.g. a MetricsCollector, applying
+// the PanicPolicy of the client. A recovered panic is logged with the logger of the client, if it has one.
+func (a *baseAPIClient) callbackSafely(ctx context.Context, callback string, fn func()) {
+	err := callSafely(callback, a.panicPolicy, func() error {
+		fn()
+		return nil
+	})
+	if err != nil && a.logger != nil {
+		a.logger.ErrorContext(ctx, "paystack callback panicked", slog.String("callback", callback),
+			slog.Any("error", err), slog.String("stack", string(err.(*PanicError).Stack)))
+	}
+}
+
+// WithPanicPolicy lets you choose what an APIClient does when a function you provide to it panics, e.g. the
+// handler of a Syncer, the OnError function of a TerminalPresenceWatcher or a MetricsCollector. By default, the panic is recovered and returned as a *PanicError.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"), p.WithPanicPolicy(p.PanicCrash))
+func WithPanicPolicy(policy PanicPolicy) ClientOptions {
+	return func(client *APIClient) {
+		client.panicPolicy = policy
+	}
+}
+
+// WithWebhookPanicPolicy lets you choose what a WebhookHandler does when the function processing an event
+// panics. By default, the panic is recovered and returned as a *PanicError, so that paystack retries the
+// delivery of the event and the other events keep being processed.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	handler := p.NewWebhookHandler("<paystack-secret-key>", p.WithWebhookPanicPolicy(p.PanicCrash))
+func WithWebhookPanicPolicy(policy PanicPolicy) WebhookOptions {
+	return func(handler *WebhookHandler) {
+		handler.panicPolicy = policy
+	}
+}
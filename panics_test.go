@@ -0,0 +1,110 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCallSafely(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	t.Run("recover converts the panic", func(t *testing.T) {
+		err := callSafely("test callback", PanicRecover, func() error {
+			panic(errBoom)
+		})
+		var panicErr *PanicError
+		if !errors.As(err, &panicErr) {
+			t.Fatalf("got error %v, want a *PanicError", err)
+		}
+		if panicErr.Callback != "test callback" || len(panicErr.Stack) == 0 {
+			t.Errorf("unexpected *PanicError %+v", panicErr)
+		}
+		if !errors.Is(err, errBoom) {
+			t.Errorf("got error %v, want it to wrap %v", err, errBoom)
+		}
+	})
+
+	t.Run("crash propagates the panic", func(t *testing.T) {
+		defer func() {
+			if recovered := recover(); recovered != errBoom {
+				t.Errorf("recovered %v, want %v", recovered, errBoom)
+			}
+		}()
+		_ = callSafely("test callback", PanicCrash, func() error {
+			panic(errBoom)
+		})
+		t.Error("panic was not propagated")
+	})
+}
+
+func TestParallelRecoversPanics(t *testing.T) {
+	errs, err := parallel(context.Background(), 3, ParallelOptions{Mode: ParallelCollectAll},
+		func(ctx context.Context, i int) error {
+			if i == 1 {
+				panic("boom")
+			}
+			return nil
+		})
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("got error %v, want a *PanicError", err)
+	}
+	if errs[0] != nil || errs[1] == nil || errs[2] != nil {
+		t.Errorf("unexpected errors %v", errs)
+	}
+}
+
+func TestWebhookHandlerRecoversPanics(t *testing.T) {
+	handler := NewWebhookHandler("sk_test_secret")
+	handler.On("charge.success", func(event WebhookEvent) error {
+		panic("boom")
+	})
+	payload := []byte(`{"event":"charge.success","data":{"reference":"ref_1"}}`)
+	err := handler.Process(payload, signWebhookPayload("sk_test_secret", payload))
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("got error %v, want a *PanicError", err)
+	}
+	if handler.FailingResources() != 1 {
+		t.Errorf("got %d failing resources, want 1", handler.FailingResources())
+	}
+}
+
+func TestTerminalPresenceWatcherRecoversOnErrorPanics(t *testing.T) {
+	var checks int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the first check fails, the next ones report the terminal online
+		if atomic.AddInt32(&checks, 1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"status":false,"message":"Terminal unreachable"}`))
+			return
+		}
+		w.Write([]byte(`{"status":true,"data":{"online":` + strconv.FormatBool(atomic.LoadInt32(&checks) > 2) + `,"available":true}}`))
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	watcher := NewTerminalPresenceWatcher(client.Terminals, time.Millisecond, "30")
+	watcher.OnError = func(terminalId string, err error) {
+		panic(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	select {
+	case transition, ok := <-watcher.Watch(ctx):
+		if !ok {
+			t.Fatal("expected the watcher to keep running after OnError panicked")
+		}
+		if !transition.Current.Online {
+			t.Errorf("unexpected transition %+v", transition)
+		}
+	case <-ctx.Done():
+		t.Fatal("expected a transition")
+	}
+}
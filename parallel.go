@@ -0,0 +1,163 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// defaultParallelWorkers is the number of workers used by fan-out helpers when ParallelOptions.Workers
+// is not set
+const defaultParallelWorkers = 4
+
+// ParallelMode specifies how the fan-out helpers e.g. TransactionClient.VerifyMany handle errors
+type ParallelMode int
+
+const (
+	// ParallelFirstError stops starting new calls after the first error and returns it
+	ParallelFirstError ParallelMode = iota
+	// ParallelCollectAll makes all the calls and returns all the errors joined together
+	ParallelCollectAll
+)
+
+// ParallelOptions configures how the fan-out helpers e.g. TransactionClient.VerifyMany make their calls
+type ParallelOptions struct {
+	// Workers is the maximum number of calls made at the same time. It defaults to 4.
+	Workers int
+	Mode    ParallelMode
+	// PanicPolicy specifies what happens when a call panics. By default, the panic is recovered and
+	// reported as the *PanicError of the call, so that the other calls keep running.
+	PanicPolicy PanicPolicy
+}
+
+// ErrDeadlineExceeded is matched by the error of a fan-out helper e.g. TransactionClient.VerifyMany whose
+// context was done before all its calls were made. The error is a *DeadlineExceededError.
+var ErrDeadlineExceeded = errors.New("paystack: deadline exceeded before all calls were made")
+
+// DeadlineExceededError is returned by a fan-out helper e.g. TransactionClient.VerifyMany when its context is
+// done, i.e. its deadline passed or it was canceled, before all its calls were made. The results of the
+// completed calls are still returned by the helper, so a job can checkpoint them and continue with the
+// remaining calls later instead of starting over. It wraps ErrDeadlineExceeded and the error of the context.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	responses, err := client.Transactions.VerifyMany(ctx, references, p.ParallelOptions{})
+//	var deadlineErr *p.DeadlineExceededError
+//	if errors.As(err, &deadlineErr) {
+//		for _, i := range deadlineErr.Completed {
+//			// checkpoint responses[i]
+//		}
+//		for _, i := range deadlineErr.Remaining {
+//			// requeue references[i]
+//		}
+//	}
+type DeadlineExceededError struct {
+	// Completed are the indexes of the inputs whose call was made, successfully or not
+	Completed []int
+	// Remaining are the indexes of the inputs whose call was not made or was aborted because the context was done
+	Remaining []int
+	// Err is the error of the context i.e. context.DeadlineExceeded or context.Canceled
+	Err error
+}
+
+func (e *DeadlineExceededError) Error() string {
+	return fmt.Sprintf("%v: %d of %d calls completed: %v", ErrDeadlineExceeded, len(e.Completed),
+		len(e.Completed)+len(e.Remaining), e.Err)
+}
+
+func (e *DeadlineExceededError) Unwrap() []error {
+	return []error{ErrDeadlineExceeded, e.Err}
+}
+
+// parallel calls fn for every index in [0, n) using a bounded number of workers. The error of every
+// index is returned alongside the error of the whole run based on options.Mode. Indexes that were not
+// started because ctx is done or an error occurred in ParallelFirstError mode have the error of ctx. A
+// *DeadlineExceededError is returned if ctx is done before every index was completed.
+func parallel(ctx context.Context, n int, options ParallelOptions, fn func(ctx context.Context, i int) error) ([]error, error) {
+	errs := make([]error, n)
+	if n == 0 {
+		return errs, nil
+	}
+	workers := options.Workers
+	if workers <= 0 {
+		workers = defaultParallelWorkers
+	}
+	if workers > n {
+		workers = n
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var mu sync.Mutex
+	var firstErr error
+	// aborted are the indexes whose call failed because ctx was done
+	aborted := make([]bool, n)
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := runCtx.Err(); err != nil {
+					errs[i] = err
+					aborted[i] = true
+					continue
+				}
+				err := callSafely(fmt.Sprintf("parallel call %d", i), options.PanicPolicy, func() error {
+					return fn(runCtx, i)
+				})
+				if err == nil {
+					continue
+				}
+				errs[i] = err
+				aborted[i] = ctx.Err() != nil
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+				if options.Mode == ParallelFirstError {
+					cancel()
+				}
+			}
+		}()
+	}
+
+	next := 0
+feed:
+	for ; next < n; next++ {
+		select {
+		case indexes <- next:
+		case <-runCtx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+	for ; next < n; next++ {
+		errs[next] = runCtx.Err()
+		aborted[next] = true
+	}
+
+	if err := ctx.Err(); err != nil {
+		deadlineErr := &DeadlineExceededError{Err: err}
+		for i := range aborted {
+			if aborted[i] {
+				deadlineErr.Remaining = append(deadlineErr.Remaining, i)
+			} else {
+				deadlineErr.Completed = append(deadlineErr.Completed, i)
+			}
+		}
+		if len(deadlineErr.Remaining) > 0 {
+			return errs, deadlineErr
+		}
+	}
+	if options.Mode == ParallelFirstError {
+		return errs, firstErr
+	}
+	return errs, errors.Join(errs...)
+}
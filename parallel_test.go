@@ -0,0 +1,94 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParallel(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	t.Run("collect all runs every index", func(t *testing.T) {
+		var calls int32
+		errs, err := parallel(context.Background(), 10, ParallelOptions{Workers: 3, Mode: ParallelCollectAll},
+			func(ctx context.Context, i int) error {
+				atomic.AddInt32(&calls, 1)
+				if i%2 == 0 {
+					return errBoom
+				}
+				return nil
+			})
+		if calls != 10 {
+			t.Errorf("got %d calls, want 10", calls)
+		}
+		if !errors.Is(err, errBoom) {
+			t.Errorf("got error %v, want %v", err, errBoom)
+		}
+		for i, e := range errs {
+			if (i%2 == 0) != (e != nil) {
+				t.Errorf("unexpected error %v for index %d", e, i)
+			}
+		}
+	})
+
+	t.Run("first error stops starting new indexes", func(t *testing.T) {
+		var calls int32
+		errs, err := parallel(context.Background(), 10, ParallelOptions{Workers: 1},
+			func(ctx context.Context, i int) error {
+				atomic.AddInt32(&calls, 1)
+				if i == 2 {
+					return errBoom
+				}
+				return nil
+			})
+		if err != errBoom {
+			t.Errorf("got error %v, want %v", err, errBoom)
+		}
+		if calls != 3 {
+			t.Errorf("got %d calls, want 3", calls)
+		}
+		if !errors.Is(errs[9], context.Canceled) {
+			t.Errorf("got error %v for an index that was not started, want %v", errs[9], context.Canceled)
+		}
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := parallel(ctx, 5, ParallelOptions{Mode: ParallelCollectAll}, func(ctx context.Context, i int) error {
+			return nil
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("got error %v, want %v", err, context.Canceled)
+		}
+	})
+}
+
+func TestParallelReturnsPartialResultsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errs, err := parallel(ctx, 6, ParallelOptions{Workers: 1, Mode: ParallelCollectAll},
+		func(ctx context.Context, i int) error {
+			if i == 2 {
+				cancel()
+				return ctx.Err()
+			}
+			return nil
+		})
+	var deadlineErr *DeadlineExceededError
+	if !errors.As(err, &deadlineErr) {
+		t.Fatalf("got error %v, want a *DeadlineExceededError", err)
+	}
+	if !errors.Is(err, ErrDeadlineExceeded) || !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want it to wrap %v and %v", err, ErrDeadlineExceeded, context.Canceled)
+	}
+	if !reflect.DeepEqual(deadlineErr.Completed, []int{0, 1}) || !reflect.DeepEqual(deadlineErr.Remaining, []int{2, 3, 4, 5}) {
+		t.Errorf("got completed %v and remaining %v", deadlineErr.Completed, deadlineErr.Remaining)
+	}
+	if errs[0] != nil || errs[1] != nil || errs[5] == nil {
+		t.Errorf("unexpected errors %v", errs)
+	}
+}
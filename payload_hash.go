@@ -0,0 +1,50 @@
+package paystack
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+)
+
+// CanonicalJSON lets you serialize v into a deterministic JSON form. The keys of objects are sorted at
+// every level and numbers are preserved exactly as they were serialized, so that two payloads with the
+// same content always have the same canonical form regardless of how they were constructed, e.g. a
+// struct and a map[string]interface{} with the same fields.
+func CanonicalJSON(v interface{}) ([]byte, error) {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	var generic interface{}
+	if err = decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+	// maps are serialized with sorted keys
+	return json.Marshal(generic)
+}
+
+// HashPayload lets you compute a stable SHA-256 hash of the canonical JSON form of a payload as a hex
+// string. It is suitable for idempotency keys, audit trails and detecting duplicate payloads in batches.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	hash, err := p.HashPayload(map[string]interface{}{"amount": 500000, "recipient": "RCP_gx2wn530m0i3w3m"})
+//	if err != nil {
+//		panic(err)
+//	}
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	resp, err := client.Transfers.Initiate("balance", 500000, "RCP_gx2wn530m0i3w3m",
+//		p.WithOptionalParameter("reference", hash[:32]))
+func HashPayload(v interface{}) (string, error) {
+	canonical, err := CanonicalJSON(v)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:]), nil
+}
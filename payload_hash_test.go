@@ -0,0 +1,30 @@
+package paystack
+
+import "testing"
+
+func TestHashPayload(t *testing.T) {
+	type transfer struct {
+		Recipient string `json:"recipient"`
+		Amount    int64  `json:"amount"`
+	}
+	fromStruct, err := HashPayload(transfer{Recipient: "RCP_1", Amount: 9007199254740993})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromMap, err := HashPayload(map[string]interface{}{"amount": int64(9007199254740993), "recipient": "RCP_1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fromStruct != fromMap {
+		t.Fatalf("expected equal hashes, got %s and %s", fromStruct, fromMap)
+	}
+	other, _ := HashPayload(map[string]interface{}{"amount": int64(9007199254740992), "recipient": "RCP_1"})
+	if other == fromMap {
+		t.Fatal("expected different payloads to have different hashes")
+	}
+
+	canonical, _ := CanonicalJSON(map[string]interface{}{"b": 1, "a": map[string]interface{}{"d": 2, "c": 1.50}})
+	if string(canonical) != `{"a":{"c":1.5,"d":2},"b":1}` {
+		t.Fatalf("unexpected canonical form %s", canonical)
+	}
+}
@@ -0,0 +1,91 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// maxSlugAttempts is the maximum number of slugs SuggestSlug checks before giving up
+const maxSlugAttempts = 50
+
+// ErrNoAvailableSlug is returned by PaymentPageClient.SuggestSlug when no available slug was found for a name
+var ErrNoAvailableSlug = errors.New("no available slug")
+
+// Slugify lets you convert name into a slug for a payment page e.g. `Jane's Bakery & Co.` becomes
+// `jane-s-bakery-co`. Characters other than ASCII letters and digits are replaced with hyphens.
+func Slugify(name string) string {
+	var slug strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if hyphen && slug.Len() > 0 {
+				slug.WriteByte('-')
+			}
+			slug.WriteRune(r)
+			hyphen = false
+			continue
+		}
+		hyphen = true
+	}
+	return slug.String()
+}
+
+// SuggestSlug lets you get an available slug for a payment page named name, for provisioning payment pages
+// without conflicts. name is converted into a slug with Slugify and its availability is checked with
+// CheckSlug, appending a numeric suffix e.g. `-2`, `-3` until an available slug is found. An error
+// wrapping ErrNoAvailableSlug is returned if none is found after 50 attempts.
+//
+// Example:
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	ppClient := p.NewPaymentPageClient(p.WithSecretKey("<paystack-secret-key>"))
+//	slug, err := ppClient.SuggestSlug(ctx, "Jane's Bakery")
+//	if err != nil {
+//		panic(err)
+//	}
+//	resp, err := ppClient.Create("Jane's Bakery", p.WithOptionalParameter("slug", slug))
+func (p *PaymentPageClient) SuggestSlug(ctx context.Context, name string) (string, error) {
+	base := Slugify(name)
+	if base == "" {
+		return "", fmt.Errorf("%w: %q has no letters or digits", ErrNoAvailableSlug, name)
+	}
+	for attempt := 1; attempt <= maxSlugAttempts; attempt++ {
+		slug := base
+		if attempt > 1 {
+			slug = fmt.Sprintf("%s-%d", base, attempt)
+		}
+		available, err := p.slugAvailable(ctx, slug)
+		if err != nil {
+			return "", err
+		}
+		if available {
+			return slug, nil
+		}
+	}
+	return "", fmt.Errorf("%w: tried %d slugs for %q", ErrNoAvailableSlug, maxSlugAttempts, name)
+}
+
+// slugAvailable checks the availability of slug. Paystack responds with a 400 status code for a taken slug,
+// which is returned as an *APIError by clients with strict errors.
+func (p *PaymentPageClient) slugAvailable(ctx context.Context, slug string) (bool, error) {
+	resp, err := p.apiCall(ctx, http.MethodGet, fmt.Sprintf("/page/check_slug_availability/%s", slug), nil)
+	resp = responseOf(resp, err)
+	if resp == nil {
+		return false, err
+	}
+	body, err := resp.envelope()
+	if err != nil {
+		return false, err
+	}
+	switch {
+	case body.Status:
+		return true, nil
+	case resp.StatusCode == http.StatusBadRequest:
+		return false, nil
+	}
+	return false, fmt.Errorf("unable to check the availability of slug %q: %d %s", slug, resp.StatusCode, body.Message)
+}
@@ -0,0 +1,75 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSlugify(t *testing.T) {
+	for name, slug := range map[string]string{
+		"Jane's Bakery & Co.": "jane-s-bakery-co",
+		"  Summer Sale 2024 ": "summer-sale-2024",
+		"Café":                "caf",
+		"!!!":                 "",
+	} {
+		if got := Slugify(name); got != slug {
+			t.Errorf("Slugify(%q) = %q, expected %q", name, got, slug)
+		}
+	}
+}
+
+func TestSuggestSlug(t *testing.T) {
+	taken := map[string]bool{"summer-sale": true, "summer-sale-2": true}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		slug := strings.TrimPrefix(r.URL.Path, "/page/check_slug_availability/")
+		if taken[slug] {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"status":false,"message":"Slug is not available"}`))
+			return
+		}
+		w.Write([]byte(`{"status":true,"message":"Slug is available"}`))
+	}))
+	defer server.Close()
+
+	for _, strict := range []bool{false, true} {
+		options := []ClientOptions{WithSecretKey("sk_test"), WithBaseUrl(server.URL)}
+		if strict {
+			options = append(options, WithStrictErrors())
+		}
+		client := NewPaymentPageClient(options...)
+
+		slug, err := client.SuggestSlug(context.Background(), "Summer Sale")
+		if err != nil {
+			t.Fatalf("strict %v: %v", strict, err)
+		}
+		if slug != "summer-sale-3" {
+			t.Fatalf("strict %v: expected the first available slug, got %q", strict, slug)
+		}
+		if _, err = client.SuggestSlug(context.Background(), "!!!"); !errors.Is(err, ErrNoAvailableSlug) {
+			t.Fatalf("strict %v: expected %v, got %v", strict, ErrNoAvailableSlug, err)
+		}
+	}
+}
+
+func TestSuggestSlugFailedCheck(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"status":false,"message":"Invalid key"}`))
+	}))
+	defer server.Close()
+
+	for _, strict := range []bool{false, true} {
+		options := []ClientOptions{WithSecretKey("sk_test"), WithBaseUrl(server.URL)}
+		if strict {
+			options = append(options, WithStrictErrors())
+		}
+		client := NewPaymentPageClient(options...)
+		if _, err := client.SuggestSlug(context.Background(), "Summer Sale"); err == nil {
+			t.Fatalf("strict %v: expected a failed check not to be read as a taken slug", strict)
+		}
+	}
+}
@@ -47,6 +47,8 @@ func NewPaymentPageClient(options ...ClientOptions) *PaymentPageClient {
 //	// the `p.WithOptionalParameter` takes in a key and value parameter, the key should match the optional parameter
 //	// from paystack documentation see https://paystack.com/docs/api/page/#create
 //	// Multiple optional parameters can be passed into `Create` each with it's `p.WithOptionalParameter`
+//	// custom fields collected on the page can be added with `p.WithCustomFields`
+//	// resp, err := ppClient.Create("Buttercup Brunch", p.WithCustomFields(p.NewCustomField("Table Number").AsRequired()))
 //
 // resp, err := ppClient.Create("Buttercup Brunch")
 //
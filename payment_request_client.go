@@ -283,7 +283,7 @@ func (p *PaymentRequestClient) Total() (*Response, error) {
 func (p *PaymentRequestClient) Finalize(code string, sendNotification bool) (*Response, error) {
 	payload := make(map[string]interface{})
 	payload["send_notification"] = sendNotification
-	return p.APICall(http.MethodPost, fmt.Sprintf("/paymentrequest/finalize/%s", code), nil)
+	return p.APICall(http.MethodPost, fmt.Sprintf("/paymentrequest/finalize/%s", code), payload)
 }
 
 // Update lets you update a payment request details on your Integration
@@ -333,7 +333,7 @@ func (p *PaymentRequestClient) Update(idOrCode string, customerIdOrCode string,
 	for _, optionalPayloadParameter := range optionalPayloadParameters {
 		payload = optionalPayloadParameter(payload)
 	}
-	return p.APICall(http.MethodPut, fmt.Sprintf("/paymentrequest/%s", idOrCode), nil)
+	return p.APICall(http.MethodPut, fmt.Sprintf("/paymentrequest/%s", idOrCode), payload)
 }
 
 // Archive lets you archive a payment request. A payment request will no longer be fetched on list or returned on verify
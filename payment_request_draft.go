@@ -0,0 +1,116 @@
+package paystack
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrPaymentRequestNotDraft is returned when attempting to modify or finalize a payment request that is
+// not a draft
+var ErrPaymentRequestNotDraft = errors.New("payment request is not a draft")
+
+// LineItem is an item of a payment request. Amount is the amount of a single item in the subunit of the
+// currency of the payment request.
+type LineItem struct {
+	Name     string `json:"name"`
+	Amount   int    `json:"amount"`
+	Quantity int    `json:"quantity,omitempty"`
+}
+
+// lineItemsTotal returns the amount of all the lineItems
+func lineItemsTotal(lineItems []LineItem) int {
+	total := 0
+	for _, item := range lineItems {
+		quantity := item.Quantity
+		if quantity == 0 {
+			quantity = 1
+		}
+		total += item.Amount * quantity
+	}
+	return total
+}
+
+// CreateDraft lets you create a payment request as a draft with lineItems. The amount of the payment
+// request is the amount of all the lineItems. A draft can be modified with UpdateLineItems before it is
+// sent to the customer with FinalizeAndNotify.
+//
+// Example:
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	prClient := p.NewPaymentRequestClient(p.WithSecretKey("<paystack-secret-key>"))
+//	resp, err := prClient.CreateDraft("CUS_xwaj0txjryg393b", []p.LineItem{
+//		{Name: "Tripod stand", Amount: 2000000, Quantity: 1},
+//		{Name: "Lenses", Amount: 300000, Quantity: 2},
+//	}, p.WithOptionalParameter("due_date", "2024-07-08"))
+func (p *PaymentRequestClient) CreateDraft(customerIdOrCode string, lineItems []LineItem,
+	optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error) {
+	optionalPayloadParameters = append(optionalPayloadParameters,
+		WithOptionalParameter("line_items", lineItems),
+		WithOptionalParameter("draft", true))
+	return p.Create(customerIdOrCode, lineItemsTotal(lineItems), optionalPayloadParameters...)
+}
+
+// UpdateLineItems lets you replace the line items of a draft payment request. The amount of the payment
+// request is updated to the amount of all the lineItems. An error wrapping ErrPaymentRequestNotDraft is
+// returned if the payment request is no longer a draft.
+//
+// Example:
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	prClient := p.NewPaymentRequestClient(p.WithSecretKey("<paystack-secret-key>"))
+//	resp, err := prClient.UpdateLineItems("PRQ_1weqqsn2wwzgft8", []p.LineItem{
+//		{Name: "Tripod stand", Amount: 2000000, Quantity: 2},
+//	})
+func (p *PaymentRequestClient) UpdateLineItems(idOrCode string, lineItems []LineItem) (*Response, error) {
+	customer, err := p.requireDraft(idOrCode)
+	if err != nil {
+		return nil, err
+	}
+	return p.Update(idOrCode, customer, lineItemsTotal(lineItems), WithOptionalParameter("line_items", lineItems))
+}
+
+// FinalizeAndNotify lets you finalize a draft payment request and send it to the customer. An error
+// wrapping ErrPaymentRequestNotDraft is returned if the payment request is no longer a draft.
+//
+// Example:
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	prClient := p.NewPaymentRequestClient(p.WithSecretKey("<paystack-secret-key>"))
+//	resp, err := prClient.FinalizeAndNotify("PRQ_1weqqsn2wwzgft8")
+func (p *PaymentRequestClient) FinalizeAndNotify(code string) (*Response, error) {
+	if _, err := p.requireDraft(code); err != nil {
+		return nil, err
+	}
+	return p.Finalize(code, true)
+}
+
+// requireDraft checks that a payment request is a draft and returns the code of its customer
+func (p *PaymentRequestClient) requireDraft(idOrCode string) (string, error) {
+	resp, err := p.FetchOne(idOrCode)
+	if err != nil {
+		return "", err
+	}
+	var paymentRequest struct {
+		Status  bool   `json:"status"`
+		Message string `json:"message"`
+		Data    struct {
+			Status   string `json:"status"`
+			Customer struct {
+				CustomerCode string `json:"customer_code"`
+			} `json:"customer"`
+		} `json:"data"`
+	}
+	if err = resp.Decode(&paymentRequest); err != nil {
+		return "", err
+	}
+	if !paymentRequest.Status {
+		return "", fmt.Errorf("unable to fetch payment request %s: %s", idOrCode, paymentRequest.Message)
+	}
+	if paymentRequest.Data.Status != "draft" {
+		return "", fmt.Errorf("%w: %s has status %q", ErrPaymentRequestNotDraft, idOrCode, paymentRequest.Data.Status)
+	}
+	return paymentRequest.Data.Customer.CustomerCode, nil
+}
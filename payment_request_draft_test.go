@@ -0,0 +1,28 @@
+package paystack
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFinalizeAndNotifyRequiresDraft(t *testing.T) {
+	finalized := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			finalized = true
+		}
+		fmt.Fprint(w, `{"status":true,"message":"ok","data":{"status":"pending","customer":{"customer_code":"CUS_1"}}}`)
+	}))
+	defer server.Close()
+	client := NewPaymentRequestClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	if _, err := client.FinalizeAndNotify("PRQ_1"); !errors.Is(err, ErrPaymentRequestNotDraft) {
+		t.Fatalf("expected %v, got %v", ErrPaymentRequestNotDraft, err)
+	}
+	if finalized {
+		t.Fatal("expected a payment request that is not a draft not to be finalized")
+	}
+}
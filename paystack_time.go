@@ -0,0 +1,27 @@
+package paystack
+
+import (
+	"fmt"
+	"time"
+)
+
+// paystackTimeLayouts are the formats of the timestamps returned by paystack. Some timestamps have an
+// offset while others, e.g. the settlement date of some settlements, do not and are in UTC.
+var paystackTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.000Z",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// ParseTime lets you parse a timestamp returned by paystack regardless of its format. The time is
+// normalized to UTC; timestamps without an offset are treated as UTC.
+func ParseTime(value string) (time.Time, error) {
+	for _, layout := range paystackTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unable to parse paystack timestamp %q", value)
+}
@@ -0,0 +1,42 @@
+package paystack
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTime(t *testing.T) {
+	want := time.Date(2024, time.May, 2, 14, 0, 0, 0, time.UTC)
+	for _, value := range []string{
+		"2024-05-02T14:00:00.000Z",
+		"2024-05-02T15:00:00+01:00",
+		"2024-05-02T14:00:00",
+		"2024-05-02 14:00:00",
+	} {
+		got, err := ParseTime(value)
+		if err != nil || !got.Equal(want) || got.Location() != time.UTC {
+			t.Errorf("ParseTime(%q) = %v, %v, expected %v", value, got, err, want)
+		}
+	}
+	if _, err := ParseTime("yesterday"); err == nil {
+		t.Error("expected an error for an invalid timestamp")
+	}
+}
+
+func TestSettlementEventSettledAt(t *testing.T) {
+	settlement, err := DecodeSettlementEvent(WebhookEvent{
+		Event: WebhookEventSettlementSuccess,
+		Data:  []byte(`{"id":1,"settlment_date":"2024-05-02T15:00:00+01:00"}`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lagos := time.FixedZone("WAT", 3600)
+	settledIn, err := settlement.SettledIn(lagos)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if settledIn.Hour() != 15 || settledIn.Location() != lagos {
+		t.Fatalf("unexpected settlement date %v", settledIn)
+	}
+}
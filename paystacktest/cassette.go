@@ -0,0 +1,213 @@
+package paystacktest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+)
+
+// CassetteMode specifies whether a Cassette records or replays interactions with paystack
+type CassetteMode int
+
+const (
+	// CassetteReplay replays the interactions of the cassette file. Requests without a recorded
+	// interaction fail.
+	CassetteReplay CassetteMode = iota
+	// CassetteRecord sends requests to paystack and records their interactions, replacing the cassette file
+	CassetteRecord
+	// CassetteAuto replays the cassette file if it exists and records it otherwise
+	CassetteAuto
+)
+
+// ErrInteractionNotRecorded is wrapped by the error of a request replayed by a Cassette that has no
+// recorded interaction for it
+var ErrInteractionNotRecorded = errors.New("paystacktest: interaction not recorded")
+
+// keyPattern matches paystack secret and public keys
+var keyPattern = regexp.MustCompile(`\b(sk|pk)_(test|live)_[A-Za-z0-9]+`)
+
+// Interaction is a request and the response paystack sent for it, as stored in a cassette file. Secret keys
+// are scrubbed from both and the `Authorization` header of the request is not stored.
+type Interaction struct {
+	Request struct {
+		Method string `json:"method"`
+		// URL is the path and queries of the request
+		URL  string `json:"url"`
+		Body string `json:"body,omitempty"`
+	} `json:"request"`
+	Response struct {
+		StatusCode int         `json:"status_code"`
+		Headers    http.Header `json:"headers,omitempty"`
+		Body       string      `json:"body"`
+	} `json:"response"`
+}
+
+// Cassette is an http.RoundTripper that records the interactions of an APIClient with paystack to a
+// cassette file and replays them, so integration tests recorded once against paystack's test mode run
+// deterministically in CI without a secret key. Replayed requests are matched by method, path and queries,
+// in the order they were recorded. It should not be instantiated directly but via the NewCassette function.
+//
+// Example
+//
+//	import (
+//		p "github.com/gray-adeyi/paystack"
+//		"github.com/gray-adeyi/paystack/paystacktest"
+//	)
+//
+//	// records testdata/verify.json on the first run with PAYSTACK_SECRET_KEY set and replays it afterwards
+//	cassette, err := paystacktest.NewCassette("testdata/verify.json", paystacktest.CassetteAuto)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	secretKey := os.Getenv("PAYSTACK_SECRET_KEY")
+//	if !cassette.Recording() {
+//		// requests are not sent to paystack when replaying, so any key works
+//		secretKey = "sk_test_replay"
+//	}
+//	client := p.NewAPIClient(p.WithSecretKey(secretKey), p.WithTransport(cassette))
+type Cassette struct {
+	// Transport sends the requests of a recording Cassette. It defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+
+	path         string
+	recording    bool
+	mu           sync.Mutex
+	interactions []Interaction
+	replayed     []bool
+}
+
+// NewCassette lets you create a Cassette backed by the cassette file at path
+func NewCassette(path string, mode CassetteMode) (*Cassette, error) {
+	cassette := &Cassette{path: path}
+	data, err := os.ReadFile(path)
+	switch {
+	case mode == CassetteRecord || (mode == CassetteAuto && errors.Is(err, os.ErrNotExist)):
+		cassette.recording = true
+		return cassette, nil
+	case err != nil:
+		return nil, err
+	}
+	if err = json.Unmarshal(data, &cassette.interactions); err != nil {
+		return nil, fmt.Errorf("paystacktest: invalid cassette %s: %w", path, err)
+	}
+	cassette.replayed = make([]bool, len(cassette.interactions))
+	return cassette, nil
+}
+
+// Recording reports whether the Cassette is recording interactions rather than replaying them
+func (c *Cassette) Recording() bool {
+	return c.recording
+}
+
+// RoundTrip lets the Cassette be used as an http.RoundTripper
+func (c *Cassette) RoundTrip(request *http.Request) (*http.Response, error) {
+	var body []byte
+	if request.Body != nil {
+		var err error
+		if body, err = io.ReadAll(request.Body); err != nil {
+			return nil, err
+		}
+		request.Body.Close()
+		request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	if c.recording {
+		return c.record(request, body)
+	}
+	return c.replay(request)
+}
+
+func (c *Cassette) record(request *http.Request, body []byte) (*http.Response, error) {
+	transport := c.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	response, err := transport.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var interaction Interaction
+	interaction.Request.Method = request.Method
+	interaction.Request.URL = request.URL.RequestURI()
+	interaction.Request.Body = scrub(string(body))
+	interaction.Response.StatusCode = response.StatusCode
+	interaction.Response.Headers = make(http.Header)
+	for key, values := range response.Header {
+		if key == "Content-Length" || key == "Content-Encoding" || key == "Set-Cookie" {
+			continue
+		}
+		for _, value := range values {
+			interaction.Response.Headers.Add(key, scrub(value))
+		}
+	}
+	interaction.Response.Body = scrub(string(responseBody))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.interactions = append(c.interactions, interaction)
+	if err = c.save(); err != nil {
+		return nil, err
+	}
+	return interaction.response(request), nil
+}
+
+func (c *Cassette) replay(request *http.Request) (*http.Response, error) {
+	url := request.URL.RequestURI()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, interaction := range c.interactions {
+		if c.replayed[i] || interaction.Request.Method != request.Method || interaction.Request.URL != url {
+			continue
+		}
+		c.replayed[i] = true
+		return interaction.response(request), nil
+	}
+	return nil, fmt.Errorf("%w: %s %s in %s", ErrInteractionNotRecorded, request.Method, url, c.path)
+}
+
+// save writes the interactions to the cassette file
+func (c *Cassette) save() error {
+	data, err := json.MarshalIndent(c.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, data, 0o644)
+}
+
+func (i Interaction) response(request *http.Request) *http.Response {
+	header := i.Response.Headers.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", i.Response.StatusCode, http.StatusText(i.Response.StatusCode)),
+		StatusCode:    i.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader([]byte(i.Response.Body))),
+		ContentLength: int64(len(i.Response.Body)),
+		Request:       request,
+	}
+}
+
+// scrub replaces the paystack keys in s, leaving only their prefix e.g. sk_test_
+func scrub(s string) string {
+	return keyPattern.ReplaceAllString(s, "${1}_${2}_[REDACTED]")
+}
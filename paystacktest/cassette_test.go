@@ -0,0 +1,63 @@
+package paystacktest
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	p "github.com/gray-adeyi/paystack"
+)
+
+func TestCassette(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassettes", "verify.json")
+	server := NewServer()
+	server.SetResponse(http.MethodGet, "/Integration/payment_session_timeout", http.StatusOK,
+		`{"status":true,"message":"ok","data":{"key":"sk_live_0123456789abcdef"}}`)
+
+	cassette, err := NewCassette(path, CassetteAuto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cassette.Recording() {
+		t.Fatal("expected a missing cassette to be recorded")
+	}
+	client := server.Client(p.WithSecretKey("sk_test_0123456789abcdef"), p.WithTransport(cassette))
+	if _, err = client.Transactions.Initialize(200000, "johndoe@example.com", p.WithOptionalParameter("reference", "ref_1")); err != nil {
+		t.Fatal(err)
+	}
+	recorded, err := client.Integration.Timeout()
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "0123456789abcdef") {
+		t.Fatalf("expected the keys to be scrubbed from the cassette, got %s", data)
+	}
+
+	cassette, err = NewCassette(path, CassetteAuto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = p.NewAPIClient(p.WithSecretKey("sk_test_replay"), p.WithBaseUrl(server.URL()), p.WithTransport(cassette))
+	if _, err = client.Transactions.Initialize(200000, "johndoe@example.com", p.WithOptionalParameter("reference", "ref_1")); err != nil {
+		t.Fatal(err)
+	}
+	replayed, err := client.Integration.Timeout()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed.StatusCode != recorded.StatusCode || !strings.Contains(string(replayed.Data), "sk_live_[REDACTED]") {
+		t.Fatalf("expected the recorded response to be replayed, got %d %s", replayed.StatusCode, replayed.Data)
+	}
+	if _, err = client.Integration.Timeout(); !errors.Is(err, ErrInteractionNotRecorded) {
+		t.Fatalf("expected %v, got %v", ErrInteractionNotRecorded, err)
+	}
+}
@@ -0,0 +1,353 @@
+// Package paystacktest provides a fake paystack server for testing code that uses an APIClient without
+// stubbing raw HTTP or calling the live API. The fake keeps the transactions, customers and transfers
+// created through it in memory and serves the common endpoints that create and retrieve them. Responses
+// of any endpoint can be replaced with canned responses. For integration tests against paystack's test
+// mode, a Cassette records the interactions of a client once and replays them afterwards.
+//
+// Example
+//
+//	import (
+//		"testing"
+//
+//		"github.com/gray-adeyi/paystack/paystacktest"
+//	)
+//
+//	func TestCheckout(t *testing.T) {
+//		server := paystacktest.NewServer()
+//		defer server.Close()
+//
+//		client := server.Client()
+//		resp, err := client.Transactions.Initialize(200000, "johndoe@example.com")
+//		// assert on the response
+//	}
+package paystacktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	p "github.com/gray-adeyi/paystack"
+)
+
+// SecretKey is the secret key of the clients returned by Server.Client
+const SecretKey = "sk_test_paystacktest"
+
+// Request is a request received by a Server
+type Request struct {
+	Method string
+	// Path is the path of the request without its queries
+	Path  string
+	Query map[string][]string
+	Body  []byte
+}
+
+// Server is a fake paystack server. It should not be instantiated directly but via the NewServer function.
+type Server struct {
+	server *httptest.Server
+
+	mu           sync.Mutex
+	nextId       int
+	transactions map[string]map[string]interface{}
+	customers    []map[string]interface{}
+	transfers    []map[string]interface{}
+	canned       map[string]cannedResponse
+	requests     []Request
+}
+
+type cannedResponse struct {
+	statusCode int
+	body       []byte
+}
+
+// NewServer lets you start a fake paystack server. It should be closed with Server.Close when the test ends.
+func NewServer() *Server {
+	s := &Server{
+		nextId:       1,
+		transactions: make(map[string]map[string]interface{}),
+		canned:       make(map[string]cannedResponse),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// URL returns the base url of the Server, to be used with paystack.WithBaseUrl
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Close shuts the Server down
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns an APIClient that sends its requests to the Server. options are applied after the
+// options that point the client to the Server.
+func (s *Server) Client(options ...p.ClientOptions) *p.APIClient {
+	options = append([]p.ClientOptions{p.WithSecretKey(SecretKey), p.WithBaseUrl(s.URL())}, options...)
+	return p.NewAPIClient(options...)
+}
+
+// SetResponse lets you replace the response of an endpoint e.g. to simulate a failure. method and path
+// are matched exactly, e.g. `GET` and `/transaction/verify/ref_123`, and body is serialized to JSON unless
+// it is a string or a []byte.
+//
+// Example
+//
+//	server.SetResponse(http.MethodPost, "/transfer", http.StatusBadRequest,
+//		`{"status":false,"message":"Your balance is not enough to fulfil this request"}`)
+func (s *Server) SetResponse(method string, path string, statusCode int, body interface{}) {
+	var data []byte
+	switch b := body.(type) {
+	case string:
+		data = []byte(b)
+	case []byte:
+		data = b
+	default:
+		var err error
+		if data, err = json.Marshal(body); err != nil {
+			panic(fmt.Sprintf("paystacktest: unable to serialize the response of %s %s: %v", method, path, err))
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.canned[method+" "+path] = cannedResponse{statusCode: statusCode, body: data}
+}
+
+// SetTransactionStatus lets you set the status of a transaction initialized through the Server e.g. to
+// `failed` or `abandoned`. Transactions are successful when they are initialized.
+func (s *Server) SetTransactionStatus(reference string, status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if transaction, ok := s.transactions[reference]; ok {
+		transaction["status"] = status
+	}
+}
+
+// Requests returns the requests received by the Server, in the order they were received
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path, Query: r.URL.Query(), Body: body})
+
+	if canned, ok := s.canned[r.Method+" "+r.URL.Path]; ok {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(canned.statusCode)
+		w.Write(canned.body)
+		return
+	}
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer sk_") {
+		fail(w, http.StatusUnauthorized, "Invalid key")
+		return
+	}
+	var payload map[string]interface{}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &payload); err != nil {
+			fail(w, http.StatusBadRequest, "Invalid JSON body")
+			return
+		}
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/transaction/initialize":
+		s.initializeTransaction(w, payload)
+	case r.Method == http.MethodGet && len(segments) == 3 && segments[0] == "transaction" && segments[1] == "verify":
+		s.verifyTransaction(w, segments[2])
+	case r.Method == http.MethodPost && r.URL.Path == "/customer":
+		s.createCustomer(w, payload)
+	case r.Method == http.MethodGet && r.URL.Path == "/customer":
+		list(w, "Customers retrieved", s.customers)
+	case len(segments) == 2 && segments[0] == "customer" && (r.Method == http.MethodGet || r.Method == http.MethodPut):
+		s.customer(w, segments[1], payload)
+	case r.Method == http.MethodPost && r.URL.Path == "/transfer":
+		s.initiateTransfer(w, payload)
+	case r.Method == http.MethodGet && r.URL.Path == "/transfer":
+		list(w, "Transfers retrieved", s.transfers)
+	case r.Method == http.MethodGet && len(segments) == 3 && segments[0] == "transfer" && segments[1] == "verify":
+		s.findTransfer(w, "Transfer retrieved", "reference", segments[2])
+	case r.Method == http.MethodGet && len(segments) == 2 && segments[0] == "transfer":
+		s.findTransfer(w, "Transfer retrieved", "transfer_code", segments[1])
+	default:
+		fail(w, http.StatusNotFound, fmt.Sprintf("paystacktest: %s %s is not implemented, set a response with SetResponse",
+			r.Method, r.URL.Path))
+	}
+}
+
+func (s *Server) initializeTransaction(w http.ResponseWriter, payload map[string]interface{}) {
+	if missing := missingFields(payload, "email", "amount"); missing != "" {
+		fail(w, http.StatusBadRequest, missing)
+		return
+	}
+	reference, _ := payload["reference"].(string)
+	if reference == "" {
+		reference = p.GenerateReference()
+	}
+	if _, exists := s.transactions[reference]; exists {
+		fail(w, http.StatusBadRequest, "Duplicate Transaction Reference")
+		return
+	}
+	currency, _ := payload["currency"].(string)
+	if currency == "" {
+		currency = "NGN"
+	}
+	accessCode := fmt.Sprintf("paystacktest%d", s.nextId)
+	s.transactions[reference] = map[string]interface{}{
+		"id":        s.id(),
+		"domain":    "test",
+		"status":    "success",
+		"reference": reference,
+		"amount":    payload["amount"],
+		"currency":  currency,
+		"channel":   "card",
+		"paid_at":   now(),
+		"customer":  map[string]interface{}{"email": payload["email"]},
+		"metadata":  payload["metadata"],
+	}
+	respond(w, http.StatusOK, "Authorization URL created", map[string]interface{}{
+		"authorization_url": p.CheckoutURL(accessCode),
+		"access_code":       accessCode,
+		"reference":         reference,
+	})
+}
+
+func (s *Server) verifyTransaction(w http.ResponseWriter, reference string) {
+	transaction, ok := s.transactions[reference]
+	if !ok {
+		fail(w, http.StatusBadRequest, "Transaction reference not found")
+		return
+	}
+	respond(w, http.StatusOK, "Verification successful", transaction)
+}
+
+func (s *Server) createCustomer(w http.ResponseWriter, payload map[string]interface{}) {
+	if missing := missingFields(payload, "email"); missing != "" {
+		fail(w, http.StatusBadRequest, missing)
+		return
+	}
+	for _, customer := range s.customers {
+		if customer["email"] == payload["email"] {
+			respond(w, http.StatusOK, "Customer created", customer)
+			return
+		}
+	}
+	id := s.id()
+	customer := map[string]interface{}{
+		"id":            id,
+		"domain":        "test",
+		"customer_code": fmt.Sprintf("CUS_paystacktest%d", id),
+		"createdAt":     now(),
+	}
+	for key, value := range payload {
+		customer[key] = value
+	}
+	s.customers = append(s.customers, customer)
+	respond(w, http.StatusOK, "Customer created", customer)
+}
+
+func (s *Server) customer(w http.ResponseWriter, emailOrCode string, payload map[string]interface{}) {
+	for _, customer := range s.customers {
+		if customer["email"] != emailOrCode && customer["customer_code"] != emailOrCode {
+			continue
+		}
+		for key, value := range payload {
+			customer[key] = value
+		}
+		respond(w, http.StatusOK, "Customer retrieved", customer)
+		return
+	}
+	fail(w, http.StatusNotFound, "Customer not found")
+}
+
+func (s *Server) initiateTransfer(w http.ResponseWriter, payload map[string]interface{}) {
+	if missing := missingFields(payload, "source", "amount", "recipient"); missing != "" {
+		fail(w, http.StatusBadRequest, missing)
+		return
+	}
+	reference, _ := payload["reference"].(string)
+	if reference == "" {
+		reference = p.GenerateReference()
+	}
+	id := s.id()
+	transfer := map[string]interface{}{
+		"id":            id,
+		"domain":        "test",
+		"status":        "success",
+		"reference":     reference,
+		"transfer_code": fmt.Sprintf("TRF_paystacktest%d", id),
+		"amount":        payload["amount"],
+		"currency":      "NGN",
+		"source":        payload["source"],
+		"reason":        payload["reason"],
+		"recipient":     payload["recipient"],
+		"createdAt":     now(),
+	}
+	s.transfers = append(s.transfers, transfer)
+	respond(w, http.StatusOK, "Transfer has been queued", transfer)
+}
+
+func (s *Server) findTransfer(w http.ResponseWriter, message string, key string, value string) {
+	for _, transfer := range s.transfers {
+		if transfer[key] == value || fmt.Sprint(transfer["id"]) == value {
+			respond(w, http.StatusOK, message, transfer)
+			return
+		}
+	}
+	fail(w, http.StatusNotFound, "Transfer not found")
+}
+
+func (s *Server) id() int {
+	id := s.nextId
+	s.nextId++
+	return id
+}
+
+func missingFields(payload map[string]interface{}, fields ...string) string {
+	for _, field := range fields {
+		if value, ok := payload[field]; !ok || value == "" || value == nil {
+			return fmt.Sprintf("%s is required", field)
+		}
+	}
+	return ""
+}
+
+func now() string {
+	return time.Now().UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+func list(w http.ResponseWriter, message string, items []map[string]interface{}) {
+	if items == nil {
+		items = []map[string]interface{}{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"status":  true,
+		"message": message,
+		"data":    items,
+		"meta":    map[string]interface{}{"total": len(items), "page": 1, "pageCount": 1, "perPage": len(items)},
+	})
+}
+
+func respond(w http.ResponseWriter, statusCode int, message string, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]interface{}{"status": true, "message": message, "data": data})
+}
+
+func fail(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]interface{}{"status": false, "message": message})
+}
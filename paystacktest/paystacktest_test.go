@@ -0,0 +1,72 @@
+package paystacktest
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	p "github.com/gray-adeyi/paystack"
+)
+
+func TestServer(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	resp, err := client.Transactions.Initialize(200000, "johndoe@example.com", p.WithOptionalParameter("reference", "ref_1"))
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the transaction to be initialized, got %v %v", resp, err)
+	}
+	server.SetTransactionStatus("ref_1", "failed")
+	resp, err = client.Transactions.Verify("ref_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var verified struct {
+		Data struct {
+			Status string `json:"status"`
+			Amount int    `json:"amount"`
+		} `json:"data"`
+	}
+	if err = json.Unmarshal(resp.Data, &verified); err != nil {
+		t.Fatal(err)
+	}
+	if verified.Data.Status != "failed" || verified.Data.Amount != 200000 {
+		t.Fatalf("expected the failed transaction, got %+v", verified.Data)
+	}
+
+	if _, err = client.Customers.Create("janedoe@example.com", "Jane", "Doe"); err != nil {
+		t.Fatal(err)
+	}
+	resp, err = client.Customers.FetchOne("janedoe@example.com")
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the customer to be retrieved, got %v %v", resp, err)
+	}
+
+	resp, err = client.Transfers.Initiate("balance", 50000, "RCP_1", p.WithOptionalParameter("reference", "trf_1"))
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the transfer to be initiated, got %v %v", resp, err)
+	}
+	resp, err = client.Transfers.Verify("trf_1")
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the transfer to be verified, got %v %v", resp, err)
+	}
+	if got := len(server.Requests()); got != 6 {
+		t.Fatalf("expected 6 requests to be recorded, got %d", got)
+	}
+}
+
+func TestServerSetResponse(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.SetResponse(http.MethodPost, "/transfer", http.StatusBadRequest,
+		`{"status":false,"message":"Your balance is not enough to fulfil this request"}`)
+
+	resp, err := server.Client().Transfers.Initiate("balance", 50000, "RCP_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected the canned response, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,97 @@
+package paystack
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidPhone is returned when a phone number is obviously invalid
+var ErrInvalidPhone = errors.New("invalid phone number")
+
+// phoneNumberingPlan is the calling code and length of the national significant number of a country
+type phoneNumberingPlan struct {
+	callingCode    string
+	nationalLength int
+}
+
+// phoneNumberingPlans are the numbering plans of the countries supported by paystack, keyed by their
+// ISO 3166-1 alpha-2 code.
+var phoneNumberingPlans = map[string]phoneNumberingPlan{
+	"NG": {"234", 10},
+	"GH": {"233", 9},
+	"KE": {"254", 9},
+	"ZA": {"27", 9},
+	"CI": {"225", 10},
+	"EG": {"20", 10},
+	"RW": {"250", 9},
+}
+
+// NormalizePhone lets you convert a phone number to the E.164 format e.g. `08012345678` in Nigeria is
+// converted to `+2348012345678`. country is the ISO 3166-1 alpha-2 code of the country of the phone
+// number e.g. `NG`. If country is empty, it is inferred from the phone number which must then be in an
+// international format. ErrInvalidPhone is returned if the phone number is obviously invalid for the
+// country.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	phone, err := p.NormalizePhone("0801 234 5678", "NG") // +2348012345678
+func NormalizePhone(phone string, country string) (string, error) {
+	international := strings.HasPrefix(strings.TrimSpace(phone), "+")
+	digits := strings.Map(func(r rune) rune {
+		switch {
+		case r >= '0' && r <= '9':
+			return r
+		case r == '+' || r == ' ' || r == '-' || r == '.' || r == '(' || r == ')':
+			return -1
+		}
+		return 'x'
+	}, phone)
+	if strings.ContainsRune(digits, 'x') || digits == "" {
+		return "", fmt.Errorf("%w: %q contains invalid characters", ErrInvalidPhone, phone)
+	}
+	if strings.HasPrefix(digits, "00") {
+		digits, international = digits[2:], true
+	}
+
+	country = strings.ToUpper(country)
+	plan, known := phoneNumberingPlans[country]
+	if country == "" {
+		if !international {
+			return "", fmt.Errorf("%w: the country of %q could not be inferred", ErrInvalidPhone, phone)
+		}
+		for _, candidate := range phoneNumberingPlans {
+			if strings.HasPrefix(digits, candidate.callingCode) {
+				plan, known = candidate, true
+				break
+			}
+		}
+		if !known {
+			// the number is in an international format for a country without a known numbering plan
+			if len(digits) < 8 || len(digits) > 15 {
+				return "", fmt.Errorf("%w: %q", ErrInvalidPhone, phone)
+			}
+			return "+" + digits, nil
+		}
+	} else if !known {
+		return "", fmt.Errorf("unsupported phone number country %q", country)
+	}
+
+	national := digits
+	switch {
+	case (international || len(digits) == len(plan.callingCode)+plan.nationalLength) &&
+		strings.HasPrefix(digits, plan.callingCode):
+		national = strings.TrimPrefix(digits, plan.callingCode)
+	case international:
+		return "", fmt.Errorf("%w: %q is not a %s phone number", ErrInvalidPhone, phone, country)
+	case len(digits) == plan.nationalLength+1 && strings.HasPrefix(digits, "0"):
+		national = digits[1:]
+	}
+	// a national significant number never starts with the trunk prefix
+	if len(national) != plan.nationalLength || strings.HasPrefix(national, "0") {
+		return "", fmt.Errorf("%w: %q", ErrInvalidPhone, phone)
+	}
+	return "+" + plan.callingCode + national, nil
+}
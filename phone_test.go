@@ -0,0 +1,35 @@
+package paystack
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		phone    string
+		country  string
+		expected string
+		err      error
+	}{
+		{"08012345678", "NG", "+2348012345678", nil},
+		{"0801 234 5678", "ng", "+2348012345678", nil},
+		{"8012345678", "NG", "+2348012345678", nil},
+		{"2348012345678", "NG", "+2348012345678", nil},
+		{"+234 801-234-5678", "", "+2348012345678", nil},
+		{"00233551234987", "", "+233551234987", nil},
+		{"0551234987", "GH", "+233551234987", nil},
+		{"+254712345678", "KE", "+254712345678", nil},
+		{"0801234567", "NG", "", ErrInvalidPhone},
+		{"+233551234987", "NG", "", ErrInvalidPhone},
+		{"0801234567a", "NG", "", ErrInvalidPhone},
+		{"08012345678", "", "", ErrInvalidPhone},
+	}
+	for _, test := range tests {
+		phone, err := NormalizePhone(test.phone, test.country)
+		if !errors.Is(err, test.err) || phone != test.expected {
+			t.Errorf("NormalizePhone(%q, %q) = %q, %v, expected %q, %v", test.phone, test.country, phone, err,
+				test.expected, test.err)
+		}
+	}
+}
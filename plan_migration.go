@@ -0,0 +1,252 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Kinds of the operations of a PlanMigration
+const (
+	PlanMigrationDisable = "disable"
+	PlanMigrationCreate  = "create"
+)
+
+// PlanMigrationOperation is a step of migrating a subscriber from one plan to another
+type PlanMigrationOperation struct {
+	// Kind is either PlanMigrationDisable or PlanMigrationCreate
+	Kind             string
+	SubscriptionCode string
+	EmailToken       string
+	Customer         string
+	Authorization    string
+	// StartDate is the `start_date` of the subscription created to the new plan. It is the next billing
+	// date of the subscription to the old plan, so that the subscriber is not billed twice.
+	StartDate *time.Time
+	// Done reports whether the operation was carried out by APIClient.MigratePlan
+	Done bool
+}
+
+// PlanMigration is the sequence of operations needed to migrate the subscribers of a plan to a clone of
+// the plan in a new currency or at a new amount. It is created by APIClient.PlanMigration and carried
+// out by APIClient.MigratePlan.
+type PlanMigration struct {
+	OldPlan  string
+	Name     string
+	Interval string
+	Currency string
+	Amount   int
+	// NewPlan is the code of the clone of the old plan. It is set by APIClient.MigratePlan.
+	NewPlan    string
+	Operations []PlanMigrationOperation
+}
+
+// Report renders the operations of the PlanMigration, so that it can be reviewed before it is carried out
+func (p PlanMigration) Report() string {
+	var b strings.Builder
+	newPlan := p.NewPlan
+	if newPlan == "" {
+		newPlan = "<new plan>"
+	}
+	fmt.Fprintf(&b, "migrate %s to %s: %q %d %s %s\n", p.OldPlan, newPlan, p.Name, p.Amount, p.Currency, p.Interval)
+	for i, operation := range p.Operations {
+		status := "pending"
+		if operation.Done {
+			status = "done"
+		}
+		switch operation.Kind {
+		case PlanMigrationDisable:
+			fmt.Fprintf(&b, "%d. [%s] disable %s of %s\n", i+1, status, operation.SubscriptionCode, operation.Customer)
+		case PlanMigrationCreate:
+			startDate := "immediately"
+			if operation.StartDate != nil {
+				startDate = operation.StartDate.UTC().Format(time.RFC3339)
+			}
+			fmt.Fprintf(&b, "%d. [%s] subscribe %s to %s starting %s\n", i+1, status, operation.Customer, newPlan, startDate)
+		}
+	}
+	return b.String()
+}
+
+// PlanMigration lets you plan the migration of the active subscribers of a plan to a clone of the plan in
+// currency at amount. No changes are made to your Integration; use PlanMigration.Report to review the
+// operations and APIClient.MigratePlan to carry them out. An error is returned if a page of the
+// subscriptions of the plan could not be retrieved, so that no subscriber is left out of the migration.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	migration, err := client.PlanMigration(context.TODO(), "PLN_gx2wn530m0i3w3m", "USD", 1000)
+//	if err != nil {
+//		panic(err)
+//	}
+//	fmt.Println(migration.Report())
+func (a *APIClient) PlanMigration(ctx context.Context, planCode string, currency string, amount int) (PlanMigration, error) {
+	resp, err := a.apiCall(ctx, http.MethodGet, fmt.Sprintf("/plan/%s", planCode), nil)
+	if err != nil {
+		return PlanMigration{}, err
+	}
+	var plan struct {
+		Status  bool   `json:"status"`
+		Message string `json:"message"`
+		Data    struct {
+			Id       json.Number `json:"id"`
+			Name     string      `json:"name"`
+			Interval string      `json:"interval"`
+		} `json:"data"`
+	}
+	if err = resp.Decode(&plan); err != nil {
+		return PlanMigration{}, err
+	}
+	if !plan.Status {
+		return PlanMigration{}, fmt.Errorf("unable to fetch plan %s: %s", planCode, plan.Message)
+	}
+	migration := PlanMigration{
+		OldPlan:  planCode,
+		Name:     plan.Data.Name,
+		Interval: plan.Data.Interval,
+		Currency: currency,
+		Amount:   amount,
+	}
+
+	list := func(queries ...Query) (*Response, error) {
+		return a.apiCall(ctx, http.MethodGet, AddQueryParamsToUrl("/subscription", queries...), nil)
+	}
+	err = forEachPage(list, func(resp *Response) (bool, error) {
+		var subscriptions struct {
+			Data []struct {
+				SubscriptionCode string     `json:"subscription_code"`
+				EmailToken       string     `json:"email_token"`
+				Status           string     `json:"status"`
+				NextPaymentDate  *time.Time `json:"next_payment_date"`
+				Customer         struct {
+					CustomerCode string `json:"customer_code"`
+				} `json:"customer"`
+				Authorization struct {
+					AuthorizationCode string `json:"authorization_code"`
+				} `json:"authorization"`
+			} `json:"data"`
+		}
+		if err := resp.Decode(&subscriptions); err != nil {
+			return false, err
+		}
+		for _, subscription := range subscriptions.Data {
+			if subscription.Status != "active" {
+				continue
+			}
+			migration.Operations = append(migration.Operations,
+				PlanMigrationOperation{
+					Kind:             PlanMigrationDisable,
+					SubscriptionCode: subscription.SubscriptionCode,
+					EmailToken:       subscription.EmailToken,
+					Customer:         subscription.Customer.CustomerCode,
+				},
+				PlanMigrationOperation{
+					Kind:          PlanMigrationCreate,
+					Customer:      subscription.Customer.CustomerCode,
+					Authorization: subscription.Authorization.AuthorizationCode,
+					StartDate:     subscription.NextPaymentDate,
+				})
+		}
+		return true, nil
+	}, WithQuery("plan", plan.Data.Id.String()))
+	if err != nil {
+		return PlanMigration{}, err
+	}
+	return migration, nil
+}
+
+// MigratePlan lets you carry out a PlanMigration created with APIClient.PlanMigration. The clone of the
+// old plan is created if it has not been created and the operations that are not done are carried out in
+// order. Carrying out the migration stops at the first error or when ctx is done; since migration records
+// the progress, it can be passed to MigratePlan again to resume it.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	migration, err := client.PlanMigration(context.TODO(), "PLN_gx2wn530m0i3w3m", "USD", 1000)
+//	if err != nil {
+//		panic(err)
+//	}
+//	if err = client.MigratePlan(context.TODO(), &migration); err != nil {
+//		fmt.Println(migration.Report())
+//		panic(err)
+//	}
+func (a *APIClient) MigratePlan(ctx context.Context, migration *PlanMigration) error {
+	if migration.NewPlan == "" {
+		payload := map[string]interface{}{
+			"name":     migration.Name,
+			"amount":   migration.Amount,
+			"interval": migration.Interval,
+			"currency": migration.Currency,
+		}
+		resp, err := a.apiCall(ctx, http.MethodPost, "/plan", payload)
+		if err != nil {
+			return err
+		}
+		var plan struct {
+			Status  bool   `json:"status"`
+			Message string `json:"message"`
+			Data    struct {
+				PlanCode string `json:"plan_code"`
+			} `json:"data"`
+		}
+		if err = resp.Decode(&plan); err != nil {
+			return err
+		}
+		if !plan.Status {
+			return fmt.Errorf("unable to create plan: %s", plan.Message)
+		}
+		migration.NewPlan = plan.Data.PlanCode
+	}
+
+	for i := range migration.Operations {
+		operation := &migration.Operations[i]
+		if operation.Done {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var resp *Response
+		var err error
+		switch operation.Kind {
+		case PlanMigrationDisable:
+			resp, err = a.apiCall(ctx, http.MethodPost, "/subscription/disable", map[string]interface{}{
+				"code":  operation.SubscriptionCode,
+				"token": operation.EmailToken,
+			})
+		case PlanMigrationCreate:
+			payload := map[string]interface{}{
+				"customer":      operation.Customer,
+				"plan":          migration.NewPlan,
+				"authorization": operation.Authorization,
+			}
+			if operation.StartDate != nil && operation.StartDate.After(time.Now()) {
+				payload["start_date"] = operation.StartDate.UTC().Format(time.RFC3339)
+			}
+			resp, err = a.apiCall(ctx, http.MethodPost, "/subscription", payload)
+		default:
+			return fmt.Errorf("unsupported plan migration operation %q", operation.Kind)
+		}
+		if err != nil {
+			return err
+		}
+		body, err := resp.envelope()
+		if err != nil {
+			return err
+		}
+		if !body.Status {
+			return fmt.Errorf("unable to %s subscription of %s: %s", operation.Kind, operation.Customer, body.Message)
+		}
+		operation.Done = true
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// planMigrationServer is a fake of the plan and subscription endpoints used by a plan migration
+type planMigrationServer struct {
+	mu sync.Mutex
+	// requests describe the mutating requests in the order they were received
+	requests []string
+	// failCreateFor is the customer whose subscription can not be created
+	failCreateFor string
+	// failPage is the page of subscriptions that can not be listed
+	failPage string
+}
+
+func (f *planMigrationServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var payload map[string]interface{}
+	json.NewDecoder(r.Body).Decode(&payload)
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/plan/PLN_old":
+		w.Write([]byte(`{"status":true,"data":{"id":7,"name":"Pro","interval":"monthly"}}`))
+	case r.Method == http.MethodGet && r.URL.Path == "/subscription":
+		page := r.URL.Query().Get("page")
+		if r.URL.Query().Get("plan") != "7" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if page == f.failPage {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"status":false,"message":"Something went wrong"}`))
+			return
+		}
+		if page == "1" {
+			w.Write([]byte(`{"status":true,"data":[
+				{"subscription_code":"SUB_1","email_token":"tok_1","status":"active","next_payment_date":"2099-01-01T00:00:00Z",
+				"customer":{"customer_code":"CUS_1"},"authorization":{"authorization_code":"AUTH_1"}},
+				{"subscription_code":"SUB_x","status":"cancelled","customer":{"customer_code":"CUS_x"}}],
+				"meta":{"page":1,"pageCount":2}}`))
+			return
+		}
+		w.Write([]byte(`{"status":true,"data":[
+			{"subscription_code":"SUB_2","email_token":"tok_2","status":"active",
+			"customer":{"customer_code":"CUS_2"},"authorization":{"authorization_code":"AUTH_2"}}],
+			"meta":{"page":2,"pageCount":2}}`))
+	case r.Method == http.MethodPost && r.URL.Path == "/plan":
+		f.requests = append(f.requests, "create plan "+payload["currency"].(string))
+		w.Write([]byte(`{"status":true,"data":{"plan_code":"PLN_new"}}`))
+	case r.Method == http.MethodPost && r.URL.Path == "/subscription/disable":
+		f.requests = append(f.requests, "disable "+payload["code"].(string))
+		w.Write([]byte(`{"status":true,"message":"Subscription disabled successfully"}`))
+	case r.Method == http.MethodPost && r.URL.Path == "/subscription":
+		customer := payload["customer"].(string)
+		if customer == f.failCreateFor {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"status":false,"message":"Authorization is invalid"}`))
+			return
+		}
+		request := "create " + customer + " on " + payload["plan"].(string)
+		if startDate, ok := payload["start_date"].(string); ok {
+			request += " from " + startDate
+		}
+		f.requests = append(f.requests, request)
+		w.Write([]byte(`{"status":true,"data":{"subscription_code":"SUB_new"}}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestPlanMigration(t *testing.T) {
+	fake := &planMigrationServer{}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	migration, err := client.PlanMigration(context.Background(), "PLN_old", "USD", 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if migration.Name != "Pro" || migration.Interval != "monthly" || len(migration.Operations) != 4 {
+		t.Fatalf("expected a disable and a create for each active subscriber, got %+v", migration)
+	}
+	if len(fake.requests) != 0 {
+		t.Fatalf("expected planning not to change the integration, got %v", fake.requests)
+	}
+	report := migration.Report()
+	for _, want := range []string{
+		"1. [pending] disable SUB_1 of CUS_1",
+		"2. [pending] subscribe CUS_1 to <new plan> starting 2099-01-01T00:00:00Z",
+		"4. [pending] subscribe CUS_2 to <new plan> starting immediately",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("expected the report to contain %q, got\n%s", want, report)
+		}
+	}
+
+	if err = client.MigratePlan(context.Background(), &migration); err != nil {
+		t.Fatal(err)
+	}
+	want := "create plan USD|disable SUB_1|create CUS_1 on PLN_new from 2099-01-01T00:00:00Z|disable SUB_2|create CUS_2 on PLN_new"
+	if got := strings.Join(fake.requests, "|"); got != want {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+	if migration.NewPlan != "PLN_new" || strings.Contains(migration.Report(), "pending") {
+		t.Fatalf("expected every operation to be done, got\n%s", migration.Report())
+	}
+}
+
+func TestPlanMigrationFailedPage(t *testing.T) {
+	server := httptest.NewServer(&planMigrationServer{failPage: "2"})
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	migration, err := client.PlanMigration(context.Background(), "PLN_old", "USD", 1000)
+	if !errors.Is(err, ErrServer) || len(migration.Operations) != 0 {
+		t.Fatalf("expected the failed page to fail the plan, got %+v %v", migration, err)
+	}
+}
+
+func TestMigratePlanResumes(t *testing.T) {
+	fake := &planMigrationServer{failCreateFor: "CUS_2"}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+	migration, err := client.PlanMigration(context.Background(), "PLN_old", "USD", 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = client.MigratePlan(context.Background(), &migration); err == nil {
+		t.Fatal("expected the failed subscription to stop the migration")
+	}
+	var done []string
+	for _, operation := range migration.Operations {
+		done = append(done, strconv.FormatBool(operation.Done))
+	}
+	if got := strings.Join(done, ","); got != "true,true,true,false" {
+		t.Fatalf("expected the migration to record its progress up to the failure, got %s", got)
+	}
+
+	fake.failCreateFor = ""
+	fake.requests = nil
+	if err = client.MigratePlan(context.Background(), &migration); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(fake.requests, "|"); got != "create CUS_2 on PLN_new" {
+		t.Fatalf("expected only the remaining operation to be carried out, got %s", got)
+	}
+}
+
+func TestMigratePlanStopsWhenCanceled(t *testing.T) {
+	fake := &planMigrationServer{}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+	migration := PlanMigration{NewPlan: "PLN_new", Operations: []PlanMigrationOperation{
+		{Kind: PlanMigrationDisable, SubscriptionCode: "SUB_1", Customer: "CUS_1"},
+	}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := client.MigratePlan(ctx, &migration); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected %v, got %v", context.Canceled, err)
+	}
+	if len(fake.requests) != 0 || migration.Operations[0].Done {
+		t.Fatalf("expected no operation to be carried out, got %v", fake.requests)
+	}
+}
@@ -0,0 +1,91 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ErrPreflightFailed is wrapped by the error of APIClient.Preflight when the client is not ready to call paystack
+var ErrPreflightFailed = errors.New("paystack preflight failed")
+
+// PreflightResult is the readiness of an APIClient to call paystack. It is returned by APIClient.Preflight and
+// it is suitable for health probes.
+type PreflightResult struct {
+	// Ready is true if paystack accepted the secret key of the client
+	Ready bool
+	// Domain is the mode of the secret key of the client i.e. test or live
+	Domain Domain
+	// Currencies are the currencies your Integration has a balance in, i.e. the currencies it can transact in
+	Currencies []string
+	// Latency is how long the authenticated call to paystack took
+	Latency   time.Duration
+	CheckedAt time.Time
+}
+
+// Preflight lets you check that an APIClient is ready to call paystack at startup. A single authenticated
+// call is made to paystack, which also opens a connection to paystack that is reused by the next call. The
+// metadata of your Integration it returns, e.g. the currencies it can transact in, is cached and can be
+// retrieved afterwards with LastPreflight. An error wrapping ErrPreflightFailed is returned alongside the
+// result if the client is not ready e.g. its secret key was rejected.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	result, err := client.Preflight(ctx)
+//	if err != nil {
+//		log.Fatalf("paystack is not ready: %v", err)
+//	}
+//	log.Printf("paystack ready in %s mode for %v", result.Domain, result.Currencies)
+func (a *APIClient) Preflight(ctx context.Context) (PreflightResult, error) {
+	secretKey, _, err := a.secretKeys(ctx)
+	result := PreflightResult{Domain: DomainFromSecretKey(secretKey), CheckedAt: time.Now()}
+	if err != nil {
+		return result, fmt.Errorf("%w: %w", ErrPreflightFailed, err)
+	}
+
+	resp, err := a.apiCall(ctx, http.MethodGet, "/balance", nil)
+	result.Latency = time.Since(result.CheckedAt)
+	if err != nil {
+		return result, fmt.Errorf("%w: %w", ErrPreflightFailed, err)
+	}
+	var balances struct {
+		Status  bool   `json:"status"`
+		Message string `json:"message"`
+		Data    []struct {
+			Currency string `json:"currency"`
+		} `json:"data"`
+	}
+	if err = resp.Decode(&balances); err != nil {
+		return result, fmt.Errorf("%w: %w", ErrPreflightFailed, err)
+	}
+	if !balances.Status {
+		return result, fmt.Errorf("%w: %d %s", ErrPreflightFailed, resp.StatusCode, balances.Message)
+	}
+	for _, balance := range balances.Data {
+		result.Currencies = append(result.Currencies, balance.Currency)
+	}
+	result.Ready = true
+
+	a.mu.Lock()
+	a.preflight = &result
+	a.mu.Unlock()
+	return result, nil
+}
+
+// LastPreflight returns the result of the last successful Preflight of the APIClient. false is returned if
+// Preflight has not succeeded yet.
+func (a *APIClient) LastPreflight() (PreflightResult, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if a.preflight == nil {
+		return PreflightResult{}, false
+	}
+	result := *a.preflight
+	result.Currencies = append([]string(nil), a.preflight.Currencies...)
+	return result, true
+}
@@ -0,0 +1,44 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestPreflight(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer sk_test_valid" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"status":false,"message":"Invalid key"}`))
+			return
+		}
+		w.Write([]byte(`{"status":true,"message":"Balances retrieved","data":[{"currency":"NGN","balance":1000},
+			{"currency":"USD","balance":0}]}`))
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(WithSecretKey("sk_test_invalid"), WithBaseUrl(server.URL))
+	result, err := client.Preflight(context.Background())
+	if !errors.Is(err, ErrPreflightFailed) || result.Ready {
+		t.Fatalf("expected the preflight to fail, got %+v %v", result, err)
+	}
+	if _, ok := client.LastPreflight(); ok {
+		t.Fatal("expected a failed preflight not to be cached")
+	}
+
+	client = NewAPIClient(WithSecretKey("sk_test_valid"), WithBaseUrl(server.URL))
+	result, err = client.Preflight(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Ready || result.Domain != DomainTest || !reflect.DeepEqual(result.Currencies, []string{"NGN", "USD"}) {
+		t.Fatalf("expected the client to be ready, got %+v", result)
+	}
+	if cached, ok := client.LastPreflight(); !ok || !reflect.DeepEqual(cached, result) {
+		t.Fatalf("expected the preflight to be cached, got %+v", cached)
+	}
+}
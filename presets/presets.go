@@ -0,0 +1,56 @@
+// Package presets contains bundles of paystack.ClientOptions with sensible defaults for common
+// deployments, so that most users can configure an APIClient with a single option. Options provided
+// after a preset override it.
+//
+// Example
+//
+//	import (
+//		p "github.com/gray-adeyi/paystack"
+//		"github.com/gray-adeyi/paystack/presets"
+//	)
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"), presets.Production())
+package presets
+
+import (
+	"time"
+
+	p "github.com/gray-adeyi/paystack"
+)
+
+// Production is the preset for long-running services. Transient failures of GET requests are retried
+// within a retry budget, rate limited requests are retried after the wait paystack asks for, connections
+// to paystack are reused aggressively and endpoints are given the default timeouts of their class.
+func Production() p.ClientOptions {
+	return combine(
+		p.WithRetryBudget(3, 60),
+		p.WithRateLimitBehavior(p.RateLimitWaitAndRetry),
+		p.WithTunedTransport(5*time.Minute),
+	)
+}
+
+// Serverless is the preset for serverless functions, which are short-lived and billed by their
+// duration. Requests are given short timeouts, failures are retried at most once, rate limited requests
+// fail fast instead of waiting and the client does not start any background goroutine.
+func Serverless() p.ClientOptions {
+	return combine(
+		p.WithRetryBudget(1, 30),
+		p.WithRateLimitBehavior(p.RateLimitFailFast),
+		p.WithTunedTransport(time.Minute),
+		p.WithEndpointTimeouts(map[p.EndpointClass]time.Duration{
+			p.EndpointClassDefault: 10 * time.Second,
+			p.EndpointClassVerify:  5 * time.Second,
+			p.EndpointClassExport:  30 * time.Second,
+			p.EndpointClassBulk:    20 * time.Second,
+		}),
+	)
+}
+
+// combine bundles options into a single option
+func combine(options ...p.ClientOptions) p.ClientOptions {
+	return func(client *p.APIClient) {
+		for _, opts := range options {
+			opts(client)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package presets
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	p "github.com/gray-adeyi/paystack"
+)
+
+func TestPresets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":true,"message":"Verification successful","data":{}}`))
+	}))
+	defer server.Close()
+
+	for name, preset := range map[string]p.ClientOptions{"Production": Production(), "Serverless": Serverless()} {
+		client := p.NewAPIClient(p.WithSecretKey("sk_test"), p.WithBaseUrl(server.URL), preset)
+		resp, err := client.Transactions.Verify("ref")
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("%s: expected status code %d, got %d", name, http.StatusOK, resp.StatusCode)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package paystack
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -199,5 +200,45 @@ func (p *ProductClient) Update(id string, name string, description string, price
 	for _, optionalPayloadParameter := range optionalPayloadParameters {
 		payload = optionalPayloadParameter(payload)
 	}
-	return p.APICall(http.MethodPut, fmt.Sprintf("/product/%s", id), nil)
+	return p.APICall(http.MethodPut, fmt.Sprintf("/product/%s", id), payload)
+}
+
+// Delete lets you delete a product on your Integration. Products that have been sold should be archived
+// with Archive instead, so that their sales history is kept.
+//
+// Example:
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	prodClient := p.NewProductClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// Alternatively, you can access a product client from an APIClient
+//	// paystackClient := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// resp, err := paystackClient.Products.Delete(ctx, "<id>")
+//
+//	resp, err := prodClient.Delete(ctx, "<id>")
+//	if err != nil {
+//		panic(err)
+//	}
+//	fmt.Println(resp.StatusCode)
+func (p *ProductClient) Delete(ctx context.Context, id string) (*Response, error) {
+	return p.apiCall(ctx, http.MethodDelete, fmt.Sprintf("/product/%s", id), nil)
+}
+
+// Archive lets you archive a product on your Integration by setting its `active` to false, so that it can
+// no longer be sold while its sales history is kept. Unlike Update, the other details of the product are
+// left unchanged.
+//
+// Example:
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	prodClient := p.NewProductClient(p.WithSecretKey("<paystack-secret-key>"))
+//	resp, err := prodClient.Archive(ctx, "<id>")
+//	if err != nil {
+//		panic(err)
+//	}
+//	fmt.Println(resp.StatusCode)
+func (p *ProductClient) Archive(ctx context.Context, id string) (*Response, error) {
+	payload := map[string]interface{}{"active": false}
+	return p.apiCall(ctx, http.MethodPut, fmt.Sprintf("/product/%s", id), payload)
 }
@@ -0,0 +1,47 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestProductClientDeleteAndArchive(t *testing.T) {
+	type request struct {
+		method  string
+		path    string
+		payload map[string]interface{}
+	}
+	requests := make(chan request, 3)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var payload map[string]interface{}
+		json.Unmarshal(body, &payload)
+		requests <- request{r.Method, r.URL.Path, payload}
+		w.Write([]byte(`{"status":true,"message":"Product updated successfully"}`))
+	}))
+	defer server.Close()
+	client := NewProductClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	if _, err := client.Delete(context.Background(), "526"); err != nil {
+		t.Fatal(err)
+	}
+	if r := <-requests; r.method != http.MethodDelete || r.path != "/product/526" {
+		t.Fatalf("expected the product to be deleted, got %s %s", r.method, r.path)
+	}
+	if _, err := client.Archive(context.Background(), "526"); err != nil {
+		t.Fatal(err)
+	}
+	if r := <-requests; r.method != http.MethodPut || r.payload["active"] != false || len(r.payload) != 1 {
+		t.Fatalf("expected only active to be updated, got %s %v", r.method, r.payload)
+	}
+	if _, err := client.Update("526", "Product Six", "Product Six Description", 500000, "USD"); err != nil {
+		t.Fatal(err)
+	}
+	if r := <-requests; r.payload["name"] != "Product Six" {
+		t.Fatalf("expected the details of the product to be sent, got %v", r.payload)
+	}
+}
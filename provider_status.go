@@ -0,0 +1,117 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// StatusPageUrl is the url of the summary of paystack's public status page
+const StatusPageUrl = "https://status.paystack.com/api/v2/summary.json"
+
+// Statuses of a ProviderComponent
+const (
+	ComponentOperational         = "operational"
+	ComponentDegradedPerformance = "degraded_performance"
+	ComponentPartialOutage       = "partial_outage"
+	ComponentMajorOutage         = "major_outage"
+	ComponentUnderMaintenance    = "under_maintenance"
+)
+
+// ProviderComponent is a component of paystack on its status page e.g. "Bank Transfers"
+type ProviderComponent struct {
+	Name      string    `json:"name"`
+	Status    string    `json:"status"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Operational reports whether the component is working normally
+func (c ProviderComponent) Operational() bool {
+	return c.Status == ComponentOperational
+}
+
+// ProviderIncident is an unresolved incident on paystack's status page
+type ProviderIncident struct {
+	Name      string    `json:"name"`
+	Status    string    `json:"status"`
+	Impact    string    `json:"impact"`
+	Shortlink string    `json:"shortlink"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ProviderStatus is the status of paystack as reported on its public status page. It is returned by
+// APIClient.ProviderStatus.
+type ProviderStatus struct {
+	// Indicator is the overall status of paystack i.e. none, minor, major or critical
+	Indicator   string
+	Description string
+	Components  []ProviderComponent
+	Incidents   []ProviderIncident
+}
+
+// Component returns the component whose name contains name, ignoring case. false is returned if there
+// is no such component.
+func (s ProviderStatus) Component(name string) (ProviderComponent, bool) {
+	for _, component := range s.Components {
+		if strings.Contains(strings.ToLower(component.Name), strings.ToLower(name)) {
+			return component, true
+		}
+	}
+	return ProviderComponent{}, false
+}
+
+// ProviderStatus lets you retrieve the status of paystack and its components from its public status page,
+// so that your application can proactively degrade e.g. hide the bank transfer channel during an
+// incident. The secret key of the client is not sent along with the request.
+//
+// Example
+//
+//	import (
+//		"context"
+//		p "github.com/gray-adeyi/paystack"
+//	)
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	status, err := client.ProviderStatus(context.TODO())
+//	if err != nil {
+//		panic(err)
+//	}
+//	if component, ok := status.Component("bank transfer"); ok && !component.Operational() {
+//		// hide the bank transfer channel
+//	}
+func (a *baseAPIClient) ProviderStatus(ctx context.Context) (ProviderStatus, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, a.statusPageUrl, nil)
+	if err != nil {
+		return ProviderStatus{}, err
+	}
+	request.Header.Set("User-Agent", a.userAgentHeader())
+	r, err := a.httpClient.Do(request)
+	if err != nil {
+		return ProviderStatus{}, err
+	}
+	defer r.Body.Close()
+	if r.StatusCode != http.StatusOK {
+		return ProviderStatus{}, fmt.Errorf("unable to retrieve paystack status: unexpected status code %d", r.StatusCode)
+	}
+
+	var summary struct {
+		Status struct {
+			Indicator   string `json:"indicator"`
+			Description string `json:"description"`
+		} `json:"status"`
+		Components []ProviderComponent `json:"components"`
+		Incidents  []ProviderIncident  `json:"incidents"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&summary); err != nil {
+		return ProviderStatus{}, err
+	}
+	return ProviderStatus{
+		Indicator:   summary.Status.Indicator,
+		Description: summary.Status.Description,
+		Components:  summary.Components,
+		Incidents:   summary.Incidents,
+	}, nil
+}
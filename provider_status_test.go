@@ -0,0 +1,35 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestProviderStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Error("expected the secret key not to be sent to the status page")
+		}
+		fmt.Fprint(w, `{"status":{"indicator":"minor","description":"Partially Degraded Service"},`+
+			`"components":[{"name":"Card Payments","status":"operational"},{"name":"Bank Transfers","status":"partial_outage"}],`+
+			`"incidents":[{"name":"Delayed transfers","status":"investigating","impact":"minor"}]}`)
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(WithSecretKey("sk_test"))
+	client.statusPageUrl = server.URL
+	status, err := client.ProviderStatus(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Indicator != "minor" || len(status.Incidents) != 1 {
+		t.Fatalf("unexpected status %+v", status)
+	}
+	component, ok := status.Component("bank transfer")
+	if !ok || component.Operational() || component.Status != ComponentPartialOutage {
+		t.Fatalf("unexpected component %+v", component)
+	}
+}
@@ -0,0 +1,129 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RateLimitBehavior specifies how an APIClient handles responses with a 429 status code, i.e. requests
+// rejected by paystack for exceeding its rate limit
+type RateLimitBehavior int
+
+const (
+	// RateLimitReturnResponse returns the response of a rate limited request like any other response. It
+	// is the default RateLimitBehavior.
+	RateLimitReturnResponse RateLimitBehavior = iota
+	// RateLimitFailFast returns a *RateLimitError for rate limited requests
+	RateLimitFailFast
+	// RateLimitWaitAndRetry waits for the duration paystack asks for in the `Retry-After` header of a rate
+	// limited request and retries it, up to maxRateLimitRetries times. A *RateLimitError is returned if the
+	// request is still rate limited or the wait would exceed maxRateLimitWait.
+	RateLimitWaitAndRetry
+)
+
+// maxRateLimitRetries is the maximum number of times a rate limited request is retried with
+// RateLimitWaitAndRetry
+const maxRateLimitRetries = 3
+
+// maxRateLimitWait is the longest wait before retrying a rate limited request with RateLimitWaitAndRetry
+var maxRateLimitWait = time.Minute
+
+// defaultRateLimitWait is the wait before retrying a rate limited request whose response has no
+// `Retry-After` header
+var defaultRateLimitWait = time.Second
+
+// RateLimitError is returned for requests rejected by paystack for exceeding its rate limit when the
+// RateLimitBehavior of the client is RateLimitFailFast or RateLimitWaitAndRetry
+type RateLimitError struct {
+	// Endpoint is the method and path of the request e.g. `GET /transaction`
+	Endpoint string
+	// RetryAfter is how long paystack asked to wait before retrying the request. It is 0 if paystack did
+	// not say.
+	RetryAfter time.Duration
+	// Response is the response of the rate limited request
+	Response *Response
+}
+
+// Is lets errors.Is match a *RateLimitError against ErrRateLimited
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+func (e *RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("paystack: %s: rate limited, retry after %s", e.Endpoint, e.RetryAfter)
+	}
+	return fmt.Sprintf("paystack: %s: rate limited", e.Endpoint)
+}
+
+// WithRateLimitBehavior lets you choose how an APIClient handles requests rejected by paystack for
+// exceeding its rate limit. By default, their responses are returned like any other response.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"),
+//		p.WithRateLimitBehavior(p.RateLimitWaitAndRetry))
+//	resp, err := client.Transactions.All()
+//	var rateLimitErr *p.RateLimitError
+//	if errors.As(err, &rateLimitErr) {
+//		// still rate limited after waiting
+//	}
+func WithRateLimitBehavior(behavior RateLimitBehavior) ClientOptions {
+	return func(client *APIClient) {
+		client.rateLimitBehavior = behavior
+	}
+}
+
+// parseRetryAfter parses the `Retry-After` header of a response, which is either a number of seconds or
+// an HTTP date. 0 is returned if the header is missing or invalid.
+func parseRetryAfter(header http.Header, now time.Time) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+	return 0
+}
+
+// handleRateLimit applies the RateLimitBehavior of the client to response, using resend to retry the
+// request
+func (a *baseAPIClient) handleRateLimit(ctx context.Context, response *Response, resend func() (*Response, error)) (*Response, error) {
+	if a.rateLimitBehavior == RateLimitReturnResponse {
+		return response, nil
+	}
+	for attempt := 0; response.StatusCode == http.StatusTooManyRequests; attempt++ {
+		rateLimitErr := &RateLimitError{Endpoint: response.endpoint, RetryAfter: response.RateLimit.RetryAfter, Response: response}
+		if a.rateLimitBehavior == RateLimitFailFast || attempt == maxRateLimitRetries {
+			return nil, rateLimitErr
+		}
+		wait := response.RateLimit.RetryAfter
+		if wait == 0 {
+			wait = defaultRateLimitWait
+		}
+		if wait > maxRateLimitWait {
+			return nil, rateLimitErr
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, requestError(ctx, response.endpoint, ctx.Err())
+		case <-timer.C:
+		}
+		var err error
+		if response, err = resend(); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
@@ -0,0 +1,72 @@
+package paystack
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithRateLimitBehavior(t *testing.T) {
+	defer func(wait time.Duration) { maxRateLimitWait = wait }(maxRateLimitWait)
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1)%2 == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"status":true,"message":"Transactions retrieved","data":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+	resp, err := client.Transactions.All()
+	if err != nil || resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected the rate limited response to be returned, got %v", err)
+	}
+
+	atomic.StoreInt32(&requests, 0)
+	client = NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL), WithRateLimitBehavior(RateLimitFailFast))
+	_, err = client.Transactions.All()
+	var rateLimitErr *RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.RetryAfter != time.Second {
+		t.Fatalf("expected a RateLimitError, got %v", err)
+	}
+
+	atomic.StoreInt32(&requests, 0)
+	client = NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL), WithRateLimitBehavior(RateLimitWaitAndRetry))
+	start := time.Now()
+	resp, err = client.Transactions.All()
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the request to be retried, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("expected to wait for the Retry-After of the response, waited %s", elapsed)
+	}
+
+	// waits longer than maxRateLimitWait are not honoured
+	maxRateLimitWait = 10 * time.Millisecond
+	atomic.StoreInt32(&requests, 0)
+	if _, err = client.Transactions.All(); !errors.As(err, &rateLimitErr) {
+		t.Fatalf("expected a RateLimitError, got %v", err)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for value, expected := range map[string]time.Duration{
+		"30":                            30 * time.Second,
+		"Mon, 01 Jan 2024 00:00:10 GMT": 10 * time.Second,
+		"":                              0,
+		"soon":                          0,
+	} {
+		header := http.Header{}
+		header.Set("Retry-After", value)
+		if got := parseRetryAfter(header, now); got != expected {
+			t.Errorf("parseRetryAfter(%q) = %s, expected %s", value, got, expected)
+		}
+	}
+}
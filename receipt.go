@@ -0,0 +1,151 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Receipt is the receipt of a successful transaction rendered by SendReceipts
+type Receipt struct {
+	Reference string
+	Email     string
+	Amount    int64
+	Currency  string
+	Channel   string
+	PaidAt    time.Time
+	Subject   string
+	// Body is the receipt rendered as plain text
+	Body string
+}
+
+// ReceiptStore is implemented by types that record the transactions whose receipts were sent by
+// SendReceipts, so that a receipt is not sent more than once when reconciliation jobs overlap.
+type ReceiptStore interface {
+	// Sent should return false if a receipt has not been sent for the transaction with the reference
+	Sent(reference string) (bool, error)
+	MarkSent(reference string, sentAt time.Time) error
+}
+
+// MemoryReceiptStore is a ReceiptStore that keeps the sent state of receipts in memory
+type MemoryReceiptStore struct {
+	mu   sync.RWMutex
+	sent map[string]time.Time
+}
+
+// NewMemoryReceiptStore creates a MemoryReceiptStore
+func NewMemoryReceiptStore() *MemoryReceiptStore {
+	return &MemoryReceiptStore{sent: make(map[string]time.Time)}
+}
+
+func (m *MemoryReceiptStore) Sent(reference string) (bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, ok := m.sent[reference]
+	return ok, nil
+}
+
+func (m *MemoryReceiptStore) MarkSent(reference string, sentAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent[reference] = sentAt
+	return nil
+}
+
+// RenderReceipt lets you render the Receipt of a transaction from its JSON, e.g. the `data` of the response
+// of TransactionClient.Verify
+func RenderReceipt(transaction json.RawMessage) (Receipt, error) {
+	flat, err := FlattenTransaction(transaction)
+	if err != nil {
+		return Receipt{}, err
+	}
+	receipt := Receipt{
+		Reference: flat.Reference,
+		Email:     flat.CustomerEmail,
+		Amount:    flat.Amount,
+		Currency:  flat.Currency,
+		Channel:   flat.Channel,
+	}
+	if flat.PaidAt != nil {
+		receipt.PaidAt = flat.PaidAt.UTC()
+	}
+	amount := fmt.Sprintf("%s %d.%02d", flat.Currency, flat.Amount/100, flat.Amount%100)
+	receipt.Subject = fmt.Sprintf("Receipt for your payment of %s", amount)
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "Thank you for your payment.\n\n")
+	fmt.Fprintf(&body, "Amount: %s\n", amount)
+	fmt.Fprintf(&body, "Reference: %s\n", flat.Reference)
+	if flat.Channel != "" {
+		fmt.Fprintf(&body, "Paid with: %s", flat.Channel)
+		if flat.AuthorizationLast4 != "" {
+			fmt.Fprintf(&body, " ending in %s", flat.AuthorizationLast4)
+		}
+		body.WriteString("\n")
+	}
+	if !receipt.PaidAt.IsZero() {
+		fmt.Fprintf(&body, "Date: %s\n", receipt.PaidAt.Format(time.RFC1123))
+	}
+	receipt.Body = body.String()
+	return receipt, nil
+}
+
+// SendReceipts lets you send receipts for verified transactions, e.g. from a reconciliation job rather than
+// webhooks. A Receipt is rendered with RenderReceipt for every successful transaction whose receipt has not
+// been sent according to store and passed to send. The transaction is marked as sent in store once send
+// succeeds. Sending stops at the first error and the number of receipts sent is returned. A panic of send is
+// returned as a *PanicError.
+//
+// Example
+//
+//	import (
+//		"context"
+//		p "github.com/gray-adeyi/paystack"
+//	)
+//
+//	sent, err := p.SendReceipts(context.TODO(), verifiedTransactions, p.NewMemoryReceiptStore(),
+//		func(receipt p.Receipt) error {
+//			return mailer.Send(receipt.Email, receipt.Subject, receipt.Body)
+//		})
+func SendReceipts(ctx context.Context, transactions []json.RawMessage, store ReceiptStore, send func(receipt Receipt) error) (int, error) {
+	sent := 0
+	for _, transaction := range transactions {
+		if err := ctx.Err(); err != nil {
+			return sent, err
+		}
+		var t struct {
+			Status string `json:"status"`
+		}
+		if err := json.Unmarshal(transaction, &t); err != nil {
+			return sent, err
+		}
+		if t.Status != "success" {
+			continue
+		}
+		receipt, err := RenderReceipt(transaction)
+		if err != nil {
+			return sent, err
+		}
+		alreadySent, err := store.Sent(receipt.Reference)
+		if err != nil {
+			return sent, err
+		}
+		if alreadySent {
+			continue
+		}
+		err = callSafely("receipt sender", PanicRecover, func() error {
+			return send(receipt)
+		})
+		if err != nil {
+			return sent, fmt.Errorf("unable to send receipt for %s: %w", receipt.Reference, err)
+		}
+		if err = store.MarkSent(receipt.Reference, time.Now()); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
@@ -0,0 +1,36 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSendReceipts(t *testing.T) {
+	transactions := []json.RawMessage{
+		[]byte(`{"id":1,"status":"success","reference":"ref_1","amount":500050,"currency":"NGN","channel":"card",` +
+			`"paid_at":"2024-05-02T14:00:00.000Z","customer":{"email":"johndoe@example.com"},"authorization":{"last4":"4081"}}`),
+		[]byte(`{"id":2,"status":"failed","reference":"ref_2","amount":1000,"currency":"NGN"}`),
+	}
+	store := NewMemoryReceiptStore()
+	var receipts []Receipt
+	send := func(receipt Receipt) error {
+		receipts = append(receipts, receipt)
+		return nil
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := SendReceipts(context.Background(), transactions, store, send); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(receipts) != 1 {
+		t.Fatalf("expected 1 receipt, got %d", len(receipts))
+	}
+	receipt := receipts[0]
+	if receipt.Email != "johndoe@example.com" || receipt.Subject != "Receipt for your payment of NGN 5000.50" ||
+		!strings.Contains(receipt.Body, "card ending in 4081") {
+		t.Fatalf("unexpected receipt %+v", receipt)
+	}
+}
@@ -0,0 +1,117 @@
+package paystack
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// redactedValue replaces the values of sensitive fields
+const redactedValue = "[REDACTED]"
+
+// sensitiveFields are the fields whose values are blanked by RedactJSON
+var sensitiveFields = map[string]bool{
+	"pin":            true,
+	"otp":            true,
+	"cvv":            true,
+	"password":       true,
+	"secret":         true,
+	"secret_key":     true,
+	"token":          true,
+	"email_token":    true,
+	"authorization":  true,
+	"card_number":    true,
+	"account_number": true,
+	"bvn":            true,
+}
+
+// panPattern matches sequences of digits that look like card numbers, optionally separated by spaces or dashes
+var panPattern = regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`)
+
+// RedactPAN lets you mask the card numbers in s, leaving only their last four digits
+func RedactPAN(s string) string {
+	return panPattern.ReplaceAllStringFunc(s, func(pan string) string {
+		digits := strings.Map(func(r rune) rune {
+			if r >= '0' && r <= '9' {
+				return r
+			}
+			return -1
+		}, pan)
+		return strings.Repeat("*", len(digits)-4) + digits[len(digits)-4:]
+	})
+}
+
+// RedactEmail lets you truncate the local part of an email to its first character e.g. johndoe@example.com
+// becomes j***@example.com
+func RedactEmail(email string) string {
+	local, domain, found := strings.Cut(email, "@")
+	if !found || local == "" {
+		return email
+	}
+	return local[:1] + "***@" + domain
+}
+
+// RedactSecretKey lets you blank a secret key, leaving only its prefix e.g. sk_live_
+func RedactSecretKey(secretKey string) string {
+	for _, prefix := range []string{"sk_test_", "sk_live_"} {
+		if strings.HasPrefix(secretKey, prefix) {
+			return prefix + redactedValue
+		}
+	}
+	return redactedValue
+}
+
+// RedactJSON lets you redact a JSON payload before logging it, so that card and OTP data are not logged
+// by accident. The values of sensitive fields like `pin`, `otp` and `cvv` are blanked, emails are
+// truncated with RedactEmail and card numbers in any string are masked with RedactPAN. data is returned
+// with card numbers masked if it is not valid JSON.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	log.Printf("paystack request: %s", p.RedactJSON(payload))
+func RedactJSON(data []byte) []byte {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return []byte(RedactPAN(string(data)))
+	}
+	redacted, err := json.Marshal(redactValue("", value))
+	if err != nil {
+		return []byte(RedactPAN(string(data)))
+	}
+	return redacted
+}
+
+func redactValue(key string, value interface{}) interface{} {
+	lowerKey := strings.ToLower(key)
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, child := range v {
+			v[k] = redactValue(k, child)
+		}
+		return v
+	case []interface{}:
+		for i, child := range v {
+			v[i] = redactValue(key, child)
+		}
+		return v
+	case string:
+		switch {
+		case sensitiveFields[lowerKey]:
+			return redactedValue
+		case lowerKey == "email" || strings.HasSuffix(lowerKey, "_email"):
+			return RedactEmail(v)
+		}
+		return RedactPAN(v)
+	case json.Number:
+		if sensitiveFields[lowerKey] {
+			return redactedValue
+		}
+		return v
+	}
+	return value
+}
@@ -0,0 +1,16 @@
+package paystack
+
+import "testing"
+
+func TestRedactJSON(t *testing.T) {
+	payload := []byte(`{"email":"johndoe@example.com","pin":"1234","card":{"number":"4084 0840 8408 4081","cvv":408},` +
+		`"metadata":{"note":"card 5078-5078-5078-5078-12 declined"},"amount":5000}`)
+	want := `{"amount":5000,"card":{"cvv":"[REDACTED]","number":"************4081"},"email":"j***@example.com",` +
+		`"metadata":{"note":"card **************7812 declined"},"pin":"[REDACTED]"}`
+	if got := string(RedactJSON(payload)); got != want {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+	if got := RedactSecretKey("sk_live_abc"); got != "sk_live_[REDACTED]" {
+		t.Fatalf("unexpected redacted secret key %s", got)
+	}
+}
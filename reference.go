@@ -0,0 +1,69 @@
+package paystack
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/gray-adeyi/paystack/limits"
+)
+
+// ErrInvalidReference is returned by ValidateReference when a reference would be rejected by paystack
+var ErrInvalidReference = errors.New("invalid reference")
+
+// GenerateReference lets you generate a unique reference for a transaction or charge. The references
+// generated only contain characters allowed by paystack in a reference.
+func GenerateReference() string {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return "ref_" + hex.EncodeToString(b)
+}
+
+// ValidateReference lets you check that a reference you generated is accepted by paystack. A reference
+// may only contain alphanumeric characters, `-`, `_`, `.` and `=` and must not be longer than
+// limits.MaxReferenceLength.
+func ValidateReference(reference string) error {
+	if reference == "" || len(reference) > limits.MaxReferenceLength {
+		return fmt.Errorf("%w: must be between 1 and %d characters long", ErrInvalidReference, limits.MaxReferenceLength)
+	}
+	for _, r := range reference {
+		isAlphanumeric := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+		if !isAlphanumeric && r != '-' && r != '.' && r != '=' && r != '_' {
+			return fmt.Errorf("%w: %q is not allowed", ErrInvalidReference, r)
+		}
+	}
+	return nil
+}
+
+// ChunkBulkTransfers lets you split transfers into batches of at most limits.MaxBulkTransfers that can
+// each be initiated with TransferClient.BulkInitiate.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	for _, batch := range p.ChunkBulkTransfers(transfers) {
+//		resp, err := client.Transfers.BulkInitiate("balance", batch)
+//		// handle the response of the batch
+//	}
+func ChunkBulkTransfers[T any](transfers []T) [][]T {
+	var batches [][]T
+	for start := 0; start < len(transfers); start += limits.MaxBulkTransfers {
+		end := start + limits.MaxBulkTransfers
+		if end > len(transfers) {
+			end = len(transfers)
+		}
+		batches = append(batches, transfers[start:end])
+	}
+	return batches
+}
+
+// isDuplicateReference checks if a Response is paystack rejecting a request because its reference
+// has already been used.
+func isDuplicateReference(r *Response) bool {
+	return errors.Is(r.AsError(), ErrDuplicateReference)
+}
@@ -0,0 +1,65 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gray-adeyi/paystack/limits"
+)
+
+func TestValidateReference(t *testing.T) {
+	if err := ValidateReference(GenerateReference()); err != nil {
+		t.Fatalf("expected a generated reference to be valid, got %v", err)
+	}
+	for _, reference := range []string{"", "ref with spaces", "ref/1", strings.Repeat("a", limits.MaxReferenceLength+1)} {
+		if err := ValidateReference(reference); !errors.Is(err, ErrInvalidReference) {
+			t.Errorf("expected %q to be invalid, got %v", reference, err)
+		}
+	}
+}
+
+func TestChunkBulkTransfers(t *testing.T) {
+	transfers := make([]int, 2*limits.MaxBulkTransfers+1)
+	batches := ChunkBulkTransfers(transfers)
+	if len(batches) != 3 {
+		t.Fatalf("expected 3 batches, got %d", len(batches))
+	}
+	if len(batches[0]) != limits.MaxBulkTransfers || len(batches[2]) != 1 {
+		t.Fatalf("unexpected batch sizes %d and %d", len(batches[0]), len(batches[2]))
+	}
+	if ChunkBulkTransfers([]int{}) != nil {
+		t.Fatal("expected no batches for no transfers")
+	}
+}
+
+func TestChargeCreateRetryUsesNewIdempotencyKey(t *testing.T) {
+	var keys, references []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		references = append(references, body["reference"].(string))
+		if len(keys) == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"status":false,"message":"Duplicate Transaction Reference"}`))
+			return
+		}
+		w.Write([]byte(`{"status":true,"message":"Charge attempted"}`))
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	ctx := WithCallOptions(context.Background(), WithIdempotencyKey("order-1"))
+	resp, err := client.Charges.CreateContext(ctx, "johndoe@example.com", "100000")
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the charge to be retried, got %v %v", resp, err)
+	}
+	if len(keys) != 2 || keys[0] != "order-1" || keys[1] == keys[0] || references[1] == references[0] {
+		t.Fatalf("expected the retry to have a new reference and idempotency key, got %v %v", references, keys)
+	}
+}
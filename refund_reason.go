@@ -0,0 +1,61 @@
+package paystack
+
+import "strings"
+
+// RefundReason is the cause of a refund. Paystack does not have a field for the reason of a refund, so
+// it is recorded at the start of the `merchant_note` of the refund with WithRefundReason.
+type RefundReason = string
+
+const (
+	RefundReasonDuplicate           RefundReason = "duplicate"
+	RefundReasonFraudulent          RefundReason = "fraudulent"
+	RefundReasonRequestedByCustomer RefundReason = "requested_by_customer"
+	RefundReasonProductNotReceived  RefundReason = "product_not_received"
+	RefundReasonProductUnacceptable RefundReason = "product_unacceptable"
+	RefundReasonServiceCancelled    RefundReason = "service_cancelled"
+	RefundReasonOther               RefundReason = "other"
+)
+
+var refundReasons = []RefundReason{
+	RefundReasonDuplicate,
+	RefundReasonFraudulent,
+	RefundReasonRequestedByCustomer,
+	RefundReasonProductNotReceived,
+	RefundReasonProductUnacceptable,
+	RefundReasonServiceCancelled,
+	RefundReasonOther,
+}
+
+// WithRefundReason lets you record the reason of a refund along with a free text merchantNote when creating
+// a refund with RefundClient.Create. They are sent as the `merchant_note` of the refund in the form
+// `reason: merchantNote` and can be separated again with ParseRefundReason.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	refundClient := p.NewRefundClient(p.WithSecretKey("<paystack-secret-key>"))
+//	resp, err := refundClient.Create("1641",
+//		p.WithRefundReason(p.RefundReasonDuplicate, "customer was charged twice at checkout"))
+func WithRefundReason(reason RefundReason, merchantNote string) OptionalPayloadParameter {
+	note := reason
+	if merchantNote != "" {
+		note += ": " + merchantNote
+	}
+	return WithOptionalParameter("merchant_note", note)
+}
+
+// ParseRefundReason lets you separate the `merchant_note` of a refund created with WithRefundReason into
+// its RefundReason and free text note. RefundReasonOther and the whole merchantNote are returned for notes
+// that were not created with WithRefundReason.
+func ParseRefundReason(merchantNote string) (RefundReason, string) {
+	for _, reason := range refundReasons {
+		if merchantNote == reason {
+			return reason, ""
+		}
+		if note, ok := strings.CutPrefix(merchantNote, reason+": "); ok {
+			return reason, note
+		}
+	}
+	return RefundReasonOther, merchantNote
+}
@@ -0,0 +1,17 @@
+package paystack
+
+import "testing"
+
+func TestRefundReason(t *testing.T) {
+	payload := WithRefundReason(RefundReasonDuplicate, "charged twice")(map[string]interface{}{})
+	note := payload["merchant_note"].(string)
+	if note != "duplicate: charged twice" {
+		t.Fatalf("unexpected merchant note %q", note)
+	}
+	if reason, text := ParseRefundReason(note); reason != RefundReasonDuplicate || text != "charged twice" {
+		t.Fatalf("unexpected reason %q and note %q", reason, text)
+	}
+	if reason, text := ParseRefundReason("customer asked nicely"); reason != RefundReasonOther || text != "customer asked nicely" {
+		t.Fatalf("unexpected reason %q and note %q", reason, text)
+	}
+}
@@ -0,0 +1,118 @@
+package paystack
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// hmacSigner signs a request with the HMAC of its method, path, authorization and body
+func hmacSigner(key string) RequestSigner {
+	return RequestSignerFunc(func(request *http.Request) error {
+		body, err := request.GetBody()
+		if err != nil {
+			return err
+		}
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return err
+		}
+		request.Header.Set("X-Egress-Signature", egressSignature(key, request.Method, request.URL.Path,
+			request.Header.Get("Authorization"), data))
+		return nil
+	})
+}
+
+func egressSignature(key string, method string, path string, authorization string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(method + " " + path + "\n" + authorization + "\n"))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestWithRequestSigner(t *testing.T) {
+	var mu sync.Mutex
+	var authorizations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		authorization := r.Header.Get("Authorization")
+		authorizations = append(authorizations, authorization)
+		if r.Header.Get("X-Egress-Signature") != egressSignature("egress", r.Method, r.URL.Path, authorization, body) {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"status":false,"message":"Invalid egress signature"}`))
+			return
+		}
+		if authorization != "Bearer sk_test_new" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"status":false,"message":"Invalid key"}`))
+			return
+		}
+		w.Write([]byte(`{"status":true,"message":"Authorization URL created"}`))
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test_old"), WithSecondarySecretKey("sk_test_new"),
+		WithBaseUrl(server.URL), WithRequestSigner(hmacSigner("egress")))
+
+	resp, err := client.Transactions.Initialize(200000, "johndoe@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the signed request to be accepted, got %d %s", resp.StatusCode, resp.Data)
+	}
+	// the request retried with the secondary key is signed again, with the body still readable
+	if len(authorizations) != 2 || authorizations[0] != "Bearer sk_test_old" || authorizations[1] != "Bearer sk_test_new" {
+		t.Fatalf("expected the request to be retried with the secondary key, got %v", authorizations)
+	}
+}
+
+func TestWithRequestSignerSeesHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":true,"message":"Charge attempted"}`))
+	}))
+	defer server.Close()
+	var headers http.Header
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL),
+		WithRequestSigner(RequestSignerFunc(func(request *http.Request) error {
+			headers = request.Header.Clone()
+			return nil
+		})))
+
+	ctx := WithCallOptions(context.Background(), WithIdempotencyKey("charge-1"))
+	if _, err := client.Charges.CreateContext(ctx, "johndoe@example.com", "100000"); err != nil {
+		t.Fatal(err)
+	}
+	if headers.Get("Authorization") != "Bearer sk_test" || headers.Get(IdempotencyKeyHeader) != "charge-1" ||
+		headers.Get("Content-Type") != "application/json" {
+		t.Fatalf("expected the headers of the request to be set before it is signed, got %v", headers)
+	}
+}
+
+func TestWithRequestSignerError(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+	errSigner := errors.New("signer unavailable")
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL),
+		WithRequestSigner(RequestSignerFunc(func(request *http.Request) error {
+			return errSigner
+		})))
+
+	if _, err := client.Transactions.Verify("ref"); !errors.Is(err, errSigner) {
+		t.Fatalf("expected %v, got %v", errSigner, err)
+	}
+	if requests != 0 {
+		t.Fatalf("expected a request that could not be signed not to be sent, got %d requests", requests)
+	}
+}
@@ -0,0 +1,41 @@
+package paystack
+
+import "time"
+
+// WithRequestTimeout lets you bound a call to timeout, including its retries and the time spent waiting out
+// rate limits, e.g. a verification in the checkout path of a request handler. It is a CallOption, so it is
+// applied to the calls made with a context created with WithCallOptions. Every call made with the context
+// gets its own timeout; the deadline of the context, if it has an earlier one, still takes precedence.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	ctx := p.WithCallOptions(r.Context(), p.WithRequestTimeout(5*time.Second))
+//	resp, err := client.Transactions.VerifyContext(ctx, "<reference>")
+func WithRequestTimeout(timeout time.Duration) CallOption {
+	return func(options *callOptions) {
+		options.timeout = timeout
+	}
+}
+
+// WithDefaultTimeout lets you create an APIClient whose requests time out after timeout unless the caller
+// has set a deadline, so a slow endpoint can not hang a request handler that forgot to set one. It replaces
+// the timeouts of the endpoint classes that are not overridden with WithEndpointTimeouts.
+//
+// The timeout applies to every request of a call separately. To bound a whole call, including its retries
+// and the time spent waiting out rate limits, use WithRequestTimeout or make the call with a context that
+// has a deadline. The deadline of the context takes precedence over the timeouts of the client.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"), p.WithDefaultTimeout(10*time.Second),
+//		p.WithEndpointTimeouts(map[p.EndpointClass]time.Duration{p.EndpointClassExport: 2 * time.Minute}))
+func WithDefaultTimeout(timeout time.Duration) ClientOptions {
+	return func(client *APIClient) {
+		client.defaultTimeout = timeout
+	}
+}
@@ -0,0 +1,101 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestContextDeadlineBoundsCall(t *testing.T) {
+	release := make(chan struct{})
+	bodies := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies <- string(b)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+	// the deadline of the context takes precedence over the longer timeout of the client
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL), WithDefaultTimeout(time.Minute))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := client.Do(ctx, http.MethodPost, "/transaction/initialize",
+		map[string]interface{}{"email": "johndoe@example.com", "amount": 200000}, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a deadline error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected the request to time out quickly, took %s", elapsed)
+	}
+	if body := <-bodies; !strings.Contains(body, "johndoe@example.com") {
+		t.Fatalf("expected the payload to be sent, got %s", body)
+	}
+}
+
+func TestWithRequestTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/slow") {
+			select {
+			case <-release:
+			case <-r.Context().Done():
+			}
+			return
+		}
+		w.Write([]byte(`{"status":true,"data":{"status":"success"}}`))
+	}))
+	defer server.Close()
+	defer close(release)
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL), WithDefaultTimeout(time.Minute))
+	ctx := WithCallOptions(context.Background(), WithRequestTimeout(50*time.Millisecond))
+
+	start := time.Now()
+	_, err := client.Transactions.VerifyContext(ctx, "slow")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a deadline error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected the call to time out quickly, took %s", elapsed)
+	}
+	_, err = client.Transactions.InitializeContext(ctx, 200000, "johndoe@example.com")
+	if err != nil {
+		t.Fatalf("expected every call made with the context to get its own timeout, got %v", err)
+	}
+	if _, err = client.Transactions.VerifyContext(ctx, "ref"); err != nil {
+		t.Fatalf("expected a fast call to complete within its timeout, got %v", err)
+	}
+}
+
+func TestWithCallOptionsKeepsOptions(t *testing.T) {
+	ctx := WithCallOptions(context.Background(), WithRequestTimeout(time.Second))
+	ctx = WithCallOptions(ctx)
+	if got := callOptionsFrom(ctx).timeout; got != time.Second {
+		t.Fatalf("expected the options of the parent context to be kept, got %s", got)
+	}
+	ctx = WithCallOptions(ctx, WithRequestTimeout(2*time.Second))
+	if got := callOptionsFrom(ctx).timeout; got != 2*time.Second {
+		t.Fatalf("expected the options to be replaced, got %s", got)
+	}
+}
+
+func TestWithDefaultTimeout(t *testing.T) {
+	client := NewAPIClient(WithDefaultTimeout(5*time.Second),
+		WithEndpointTimeouts(map[EndpointClass]time.Duration{EndpointClassExport: time.Minute}))
+	if got := client.endpointTimeout("/transaction/verify/ref"); got != 5*time.Second {
+		t.Errorf("expected the default timeout, got %s", got)
+	}
+	if got := client.endpointTimeout("/transaction/export"); got != time.Minute {
+		t.Errorf("expected the timeout of the endpoint class, got %s", got)
+	}
+}
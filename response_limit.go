@@ -0,0 +1,68 @@
+package paystack
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ResponseTooLargeError is returned when the body of a response exceeds the limit set with
+// WithMaxResponseBytes. The body is not read past the limit.
+type ResponseTooLargeError struct {
+	// Endpoint is the method and path of the request e.g. `GET /transaction/export`
+	Endpoint string
+	// StatusCode is the status code of the response
+	StatusCode int
+	// ContentType is the `Content-Type` of the response, which is useful to spot responses that did
+	// not come from paystack e.g. an HTML page returned by a misrouted proxy
+	ContentType string
+	Limit       int64
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("paystack: %s: response of status %d and content type %q exceeds the limit of %d bytes",
+		e.Endpoint, e.StatusCode, e.ContentType, e.Limit)
+}
+
+// WithMaxResponseBytes lets you create an APIClient that aborts reading responses larger than n bytes,
+// e.g. a huge HTML page returned by a misrouted proxy, returning a *ResponseTooLargeError instead. This
+// protects the memory of applications running in constrained environments. Responses are not limited
+// by default.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"), p.WithMaxResponseBytes(5<<20))
+//	resp, err := client.Transactions.All()
+//	var tooLarge *p.ResponseTooLargeError
+//	if errors.As(err, &tooLarge) {
+//		log.Printf("unexpected %s response from %s", tooLarge.ContentType, tooLarge.Endpoint)
+//	}
+func WithMaxResponseBytes(n int64) ClientOptions {
+	return func(client *APIClient) {
+		client.maxResponseBytes = n
+	}
+}
+
+// limitBody limits the body of r to the limit set with WithMaxResponseBytes, returning a
+// *ResponseTooLargeError when r is known to exceed it from its `Content-Length`
+func (a *baseAPIClient) limitBody(r *http.Response, endpoint string) (io.Reader, error) {
+	if a.maxResponseBytes <= 0 {
+		return r.Body, nil
+	}
+	if r.ContentLength > a.maxResponseBytes {
+		return nil, a.responseTooLarge(r, endpoint)
+	}
+	// one more byte than the limit is read to detect bodies exceeding it
+	return io.LimitReader(r.Body, a.maxResponseBytes+1), nil
+}
+
+func (a *baseAPIClient) responseTooLarge(r *http.Response, endpoint string) *ResponseTooLargeError {
+	return &ResponseTooLargeError{
+		Endpoint:    endpoint,
+		StatusCode:  r.StatusCode,
+		ContentType: r.Header.Get("Content-Type"),
+		Limit:       a.maxResponseBytes,
+	}
+}
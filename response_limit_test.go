@@ -0,0 +1,46 @@
+package paystack
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWithMaxResponseBytes(t *testing.T) {
+	page := "<html>" + strings.Repeat("a", 1024) + "</html>"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		if r.URL.Path == "/transaction" {
+			// a chunked response has no content length
+			w.(http.Flusher).Flush()
+		}
+		w.Write([]byte(page))
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL), WithMaxResponseBytes(512))
+
+	for _, call := range []func() (*Response, error){
+		func() (*Response, error) { return client.Transactions.Verify("ref") },
+		func() (*Response, error) { return client.Transactions.All() },
+	} {
+		_, err := call()
+		var tooLarge *ResponseTooLargeError
+		if !errors.As(err, &tooLarge) {
+			t.Fatalf("expected a ResponseTooLargeError, got %v", err)
+		}
+		if tooLarge.ContentType != "text/html" || tooLarge.Limit != 512 {
+			t.Fatalf("unexpected error %+v", tooLarge)
+		}
+	}
+
+	client = NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL), WithMaxResponseBytes(int64(len(page))))
+	resp, err := client.Transactions.All()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(resp.Data) != page {
+		t.Fatal("expected a response within the limit to be read in full")
+	}
+}
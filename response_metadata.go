@@ -0,0 +1,75 @@
+package paystack
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// requestIdHeaders are the headers that may carry the id paystack assigns to a request, in order of preference.
+// They are in their canonical form, which http.Header.Get looks up without allocating.
+var requestIdHeaders = []string{"X-Request-Id", "Cf-Ray"}
+
+// RateLimitInfo is the rate limit information paystack returns in the headers of a response. Its fields are
+// zero when the headers are absent.
+type RateLimitInfo struct {
+	// Limit is the number of requests allowed in the current window
+	Limit int
+	// Remaining is the number of requests left in the current window
+	Remaining int
+	// Reset is when the current window ends
+	Reset time.Time
+	// RetryAfter is how long paystack asked to wait before retrying a rate limited request
+	RetryAfter time.Duration
+}
+
+// clone returns a copy of the Response that shares none of its mutable state
+func (r *Response) clone() *Response {
+	response := *r
+	response.Data = append([]byte(nil), r.Data...)
+	response.Headers = r.Headers.Clone()
+	return &response
+}
+
+// Endpoint returns the method and path of the request the Response is for e.g. `GET /transaction`
+func (r *Response) Endpoint() string {
+	return r.endpoint
+}
+
+// requestId returns the id of the request of a response with header
+func requestId(header http.Header) string {
+	for _, name := range requestIdHeaders {
+		if id := header.Get(name); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// parseRateLimit returns the RateLimitInfo in header
+func parseRateLimit(header http.Header, now time.Time) RateLimitInfo {
+	info := RateLimitInfo{
+		Limit:      headerInt(header, "X-Ratelimit-Limit"),
+		Remaining:  headerInt(header, "X-Ratelimit-Remaining"),
+		RetryAfter: parseRetryAfter(header, now),
+	}
+	// the reset may be a unix timestamp or the number of seconds until the window ends
+	if reset := headerInt(header, "X-Ratelimit-Reset"); reset > 1e9 {
+		info.Reset = time.Unix(int64(reset), 0)
+	} else if reset > 0 {
+		info.Reset = now.Add(time.Duration(reset) * time.Second)
+	}
+	return info
+}
+
+// headerInt returns the integer value of the header with name, which must be in its canonical form, or zero
+// if the header is absent or not an integer
+func headerInt(header http.Header, name string) int {
+	value := header.Get(name)
+	if value == "" {
+		// Atoi allocates the error of an empty value
+		return 0
+	}
+	number, _ := strconv.Atoi(value)
+	return number
+}
@@ -0,0 +1,58 @@
+package paystack
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestResponseMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req_123")
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		w.Header().Set("X-RateLimit-Reset", "30")
+		w.Write([]byte(`{"status":true}`))
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	start := time.Now()
+	resp, err := client.Transactions.Verify("ref")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.RequestId != "req_123" || resp.Headers.Get("X-Request-Id") != "req_123" {
+		t.Errorf("expected the request id to be exposed, got %q", resp.RequestId)
+	}
+	if resp.RateLimit.Limit != 100 || resp.RateLimit.Remaining != 42 {
+		t.Errorf("expected the rate limit to be parsed, got %+v", resp.RateLimit)
+	}
+	if reset := resp.RateLimit.Reset.Sub(start); reset < 29*time.Second || reset > 31*time.Second {
+		t.Errorf("expected the window to reset in 30 seconds, got %s", reset)
+	}
+	if resp.Endpoint() != "GET /transaction/verify/ref" {
+		t.Errorf("expected the endpoint of the request, got %q", resp.Endpoint())
+	}
+}
+
+func TestParseRateLimitUnixReset(t *testing.T) {
+	header := http.Header{"X-Ratelimit-Reset": {"1700000000"}, "Retry-After": {"5"}}
+	info := parseRateLimit(header, time.Now())
+	if !info.Reset.Equal(time.Unix(1700000000, 0)) || info.RetryAfter != 5*time.Second {
+		t.Fatalf("expected the reset timestamp and retry after to be parsed, got %+v", info)
+	}
+}
+
+func TestParseResponseMetadataDoesNotAllocate(t *testing.T) {
+	header := http.Header{"Content-Type": {"application/json"}}
+	now := time.Now()
+	allocs := testing.AllocsPerRun(100, func() {
+		requestId(header)
+		parseRateLimit(header, now)
+	})
+	if allocs != 0 {
+		t.Fatalf("expected the metadata of a response without it to be parsed without allocating, got %v allocs", allocs)
+	}
+}
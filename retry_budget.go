@@ -0,0 +1,110 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// retryBackoff is the delay before the first retry of a request. It doubles on every subsequent retry.
+var retryBackoff = 200 * time.Millisecond
+
+// WithRetryBudget lets you create an APIClient that retries GET requests that fail with a network
+// error, a 429 or a 5xx status code up to maxRetries times. Retries are drawn from a budget of
+// retriesPerMinute shared by every goroutine using the client, so a burst of failures during a
+// paystack incident does not amplify the traffic sent to paystack. Once the budget is exhausted,
+// failures are returned immediately until it refills.
+//
+// Only GET requests are retried since retrying other requests e.g. a charge could have them
+// processed more than once.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"), p.WithRetryBudget(3, 60))
+func WithRetryBudget(maxRetries int, retriesPerMinute int) ClientOptions {
+	return func(client *APIClient) {
+		client.maxRetries = maxRetries
+		client.retryBudget = newRetryBudget(retriesPerMinute)
+	}
+}
+
+// retryBudget is a token bucket of retry attempts that refills continuously
+type retryBudget struct {
+	mu       sync.Mutex
+	tokens   float64
+	capacity float64
+	perToken time.Duration
+	last     time.Time
+}
+
+func newRetryBudget(retriesPerMinute int) *retryBudget {
+	budget := &retryBudget{capacity: float64(retriesPerMinute), tokens: float64(retriesPerMinute), last: time.Now()}
+	if retriesPerMinute > 0 {
+		budget.perToken = time.Minute / time.Duration(retriesPerMinute)
+	}
+	return budget
+}
+
+// take reports whether a retry may be attempted, consuming a token if so
+func (b *retryBudget) take(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.perToken > 0 {
+		b.tokens += float64(now.Sub(b.last)) / float64(b.perToken)
+		if b.tokens > b.capacity {
+			b.tokens = b.capacity
+		}
+	}
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// isRetryable reports whether the outcome of a request is a transient failure. Rate limited requests are
+// left to the RateLimitBehavior of the client unless it is RateLimitReturnResponse.
+func (a *baseAPIClient) isRetryable(response *Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, ErrCircuitOpen)
+	}
+	if response.StatusCode == http.StatusTooManyRequests {
+		return a.rateLimitBehavior == RateLimitReturnResponse
+	}
+	return response.StatusCode >= http.StatusInternalServerError
+}
+
+// doRequestWithRetries sends a request, retrying transient failures of GET requests within the retry
+// budget of the client. Retries are abandoned as soon as ctx is done.
+func (a *baseAPIClient) doRequestWithRetries(ctx context.Context, method string, endPointPath string, body []byte, headers http.Header, secretKey string) (*Response, error) {
+	response, err := a.doRequest(ctx, method, endPointPath, body, headers, secretKey)
+	if method != http.MethodGet || a.retryBudget == nil {
+		return response, err
+	}
+	backoff := retryBackoff
+	for attempt := 0; attempt < a.maxRetries && ctx.Err() == nil && a.isRetryable(response, err); attempt++ {
+		if !a.retryBudget.take(time.Now()) {
+			break
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, requestError(ctx, method+" "+endPointPath, ctx.Err())
+		case <-timer.C:
+		}
+		backoff *= 2
+		retryResponse, retryErr := a.doRequest(ctx, method, endPointPath, body, headers, secretKey)
+		if errors.Is(retryErr, ErrCircuitOpen) {
+			// the outcome of the last request that was sent is more useful than the rejected retry
+			break
+		}
+		response, err = retryResponse, retryErr
+	}
+	return response, err
+}
@@ -0,0 +1,60 @@
+package paystack
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithRetryBudget(t *testing.T) {
+	defer func(backoff time.Duration) { retryBackoff = backoff }(retryBackoff)
+	retryBackoff = time.Millisecond
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL), WithRetryBudget(3, 2))
+
+	resp, err := client.Transactions.Verify("ref")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected status code %d, got %d", http.StatusServiceUnavailable, resp.StatusCode)
+	}
+	if got := atomic.LoadInt32(&requests); got != 3 {
+		t.Fatalf("expected 3 requests with a budget of 2 retries, got %d", got)
+	}
+	// the budget is exhausted so the next failure is not retried
+	if _, err = client.Transactions.Verify("ref"); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 4 {
+		t.Fatalf("expected 4 requests after the budget is exhausted, got %d", got)
+	}
+	// requests other than GET are never retried
+	if _, err = client.Customers.Create("johndoe@example.com", "John", "Doe"); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 5 {
+		t.Fatalf("expected 5 requests, got %d", got)
+	}
+}
+
+func TestRetryBudgetRefills(t *testing.T) {
+	now := time.Now()
+	budget := newRetryBudget(60)
+	budget.last = now
+	budget.tokens = 0
+	if budget.take(now) {
+		t.Fatal("expected an empty budget to deny a retry")
+	}
+	if !budget.take(now.Add(time.Second)) {
+		t.Fatal("expected the budget to refill a retry per second")
+	}
+}
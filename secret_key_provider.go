@@ -0,0 +1,56 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+)
+
+// SecretKeyProvider is implemented by types that provide the secret key of an APIClient e.g. from a secret
+// manager. SecretKey is called before every call of the client, so a key rotated in the secret manager is
+// used without rebuilding the client. Implementations should cache the key since they are called often, and
+// they must be safe for concurrent use.
+type SecretKeyProvider interface {
+	SecretKey(ctx context.Context) (string, error)
+}
+
+// SecretKeyProviderFunc is an adapter that allows the use of an ordinary function as a SecretKeyProvider
+type SecretKeyProviderFunc func(ctx context.Context) (string, error)
+
+// SecretKey calls f(ctx)
+func (f SecretKeyProviderFunc) SecretKey(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// WithSecretKeyProvider lets you set a SecretKeyProvider that provides the secret key of an APIClient instead
+// of the fixed key set with WithSecretKey or RotateSecretKey. A call fails without being sent to paystack if
+// the provider returns an error. The secondary secret key set with WithSecondarySecretKey is still used when
+// paystack rejects the provided key.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKeyProvider(p.SecretKeyProviderFunc(func(ctx context.Context) (string, error) {
+//		return secrets.Get(ctx, "paystack-secret-key")
+//	})))
+func WithSecretKeyProvider(provider SecretKeyProvider) ClientOptions {
+	return func(client *APIClient) {
+		client.secretKeyProvider = provider
+	}
+}
+
+// secretKeys returns the primary and secondary secret keys of the client. The primary key is retrieved from
+// the SecretKeyProvider of the client if it has one.
+func (a *baseAPIClient) secretKeys(ctx context.Context) (string, string, error) {
+	a.mu.RLock()
+	secretKey, secondarySecretKey, provider := a.secretKey, a.secondarySecretKey, a.secretKeyProvider
+	a.mu.RUnlock()
+	if provider == nil {
+		return secretKey, secondarySecretKey, nil
+	}
+	secretKey, err := provider.SecretKey(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("unable to retrieve the paystack secret key: %w", err)
+	}
+	return secretKey, secondarySecretKey, nil
+}
@@ -0,0 +1,46 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestSecretKeyProvider(t *testing.T) {
+	server := newTestServer(t, "sk_new")
+	var current atomic.Value
+	current.Store("sk_old")
+	errUnavailable := errors.New("secret manager unavailable")
+	client := NewAPIClient(WithBaseUrl(server.URL),
+		WithSecretKeyProvider(SecretKeyProviderFunc(func(ctx context.Context) (string, error) {
+			key := current.Load().(string)
+			if key == "" {
+				return "", errUnavailable
+			}
+			return key, nil
+		})))
+
+	r, err := client.Transactions.Verify("ref")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected status code %d, got %d", http.StatusUnauthorized, r.StatusCode)
+	}
+
+	current.Store("sk_new")
+	r, err = client.Transactions.Verify("ref")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, r.StatusCode)
+	}
+
+	current.Store("")
+	if _, err = client.Transactions.Verify("ref"); !errors.Is(err, errUnavailable) {
+		t.Fatalf("expected the error of the provider, got %v", err)
+	}
+}
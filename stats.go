@@ -0,0 +1,149 @@
+package paystack
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// statsWindow is how far back the samples summarized by APIClient.Stats go
+const statsWindow = 5 * time.Minute
+
+// maxStatsSamples is the maximum number of samples kept per endpoint family
+const maxStatsSamples = 1024
+
+// EndpointStats summarizes the requests made to an endpoint family e.g. `transaction` within the last
+// five minutes. It is returned by APIClient.Stats.
+type EndpointStats struct {
+	// Family is the first segment of the path of the endpoints e.g. `transaction` for `/transaction/verify/:reference`
+	Family   string
+	Requests int
+	// Successes is the number of requests that received a response with a status code below 400
+	Successes int
+	// ClientErrors is the number of requests that received a response with a 4xx status code
+	ClientErrors int
+	// Errors is the number of requests that failed or received a response with a 5xx status code
+	Errors int
+	P50    time.Duration
+	P95    time.Duration
+}
+
+// ErrorRate returns the fraction of the requests that failed or received a response with a 5xx status code
+func (e EndpointStats) ErrorRate() float64 {
+	if e.Requests == 0 {
+		return 0
+	}
+	return float64(e.Errors) / float64(e.Requests)
+}
+
+type statsSample struct {
+	at         time.Time
+	latency    time.Duration
+	statusCode int
+}
+
+// statsRing holds the most recent samples of an endpoint family
+type statsRing struct {
+	samples []statsSample
+	next    int
+}
+
+// statsCollector collects the latency and outcome of every request made by an APIClient
+type statsCollector struct {
+	mu       sync.Mutex
+	families map[string]*statsRing
+}
+
+func newStatsCollector() *statsCollector {
+	return &statsCollector{families: make(map[string]*statsRing)}
+}
+
+// record adds a sample for the request to endPointPath. statusCode is 0 if the request failed.
+func (s *statsCollector) record(endPointPath string, latency time.Duration, statusCode int) {
+	family := endpointFamily(endPointPath)
+	sample := statsSample{at: time.Now(), latency: latency, statusCode: statusCode}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ring, ok := s.families[family]
+	if !ok {
+		ring = &statsRing{}
+		s.families[family] = ring
+	}
+	if len(ring.samples) < maxStatsSamples {
+		ring.samples = append(ring.samples, sample)
+		return
+	}
+	ring.samples[ring.next] = sample
+	ring.next = (ring.next + 1) % maxStatsSamples
+}
+
+func (s *statsCollector) snapshot() []EndpointStats {
+	since := time.Now().Add(-statsWindow)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := make([]EndpointStats, 0, len(s.families))
+	for family, ring := range s.families {
+		endpointStats := EndpointStats{Family: family}
+		latencies := make([]time.Duration, 0, len(ring.samples))
+		for _, sample := range ring.samples {
+			if sample.at.Before(since) {
+				continue
+			}
+			endpointStats.Requests++
+			switch {
+			case sample.statusCode == 0 || sample.statusCode >= 500:
+				endpointStats.Errors++
+			case sample.statusCode >= 400:
+				endpointStats.ClientErrors++
+			default:
+				endpointStats.Successes++
+			}
+			latencies = append(latencies, sample.latency)
+		}
+		if endpointStats.Requests == 0 {
+			continue
+		}
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		endpointStats.P50 = percentile(latencies, 50)
+		endpointStats.P95 = percentile(latencies, 95)
+		stats = append(stats, endpointStats)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Family < stats[j].Family })
+	return stats
+}
+
+// percentile returns the p-th percentile of sorted latencies using the nearest rank method
+func percentile(latencies []time.Duration, p int) time.Duration {
+	rank := (p*len(latencies) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return latencies[rank-1]
+}
+
+// endpointFamily returns the first segment of the path of an endpoint
+func endpointFamily(endPointPath string) string {
+	path, _, _ := strings.Cut(strings.TrimLeft(endPointPath, "/"), "?")
+	family, _, _ := strings.Cut(path, "/")
+	return family
+}
+
+// Stats lets you retrieve the number of successful and failed requests and the P50 and P95 latencies of
+// each endpoint family within the last five minutes, so that you can export the health of your
+// integration with paystack without a full metrics integration.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	for _, stats := range client.Stats() {
+//		fmt.Printf("%s: %d requests, %.2f%% errors, p95 %s\n", stats.Family, stats.Requests,
+//			stats.ErrorRate()*100, stats.P95)
+//	}
+func (a *baseAPIClient) Stats() []EndpointStats {
+	return a.stats.snapshot()
+}
@@ -0,0 +1,32 @@
+package paystack
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStats(t *testing.T) {
+	collector := newStatsCollector()
+	for i := 1; i <= 20; i++ {
+		collector.record("/transaction/verify/ref", time.Duration(i)*time.Millisecond, 200)
+	}
+	collector.record("/transaction/initialize", 30*time.Millisecond, 400)
+	collector.record("/transfer?perPage=50", 10*time.Millisecond, 0)
+	collector.record("/transfer", 10*time.Millisecond, 503)
+
+	stats := collector.snapshot()
+	if len(stats) != 2 {
+		t.Fatalf("expected 2 endpoint families, got %+v", stats)
+	}
+	transaction, transfer := stats[0], stats[1]
+	if transaction.Family != "transaction" || transaction.Requests != 21 || transaction.Successes != 20 ||
+		transaction.ClientErrors != 1 || transaction.Errors != 0 {
+		t.Fatalf("unexpected stats %+v", transaction)
+	}
+	if transaction.P50 != 11*time.Millisecond || transaction.P95 != 20*time.Millisecond {
+		t.Fatalf("unexpected latencies p50=%s p95=%s", transaction.P50, transaction.P95)
+	}
+	if transfer.Family != "transfer" || transfer.Errors != 2 || transfer.ErrorRate() != 1 {
+		t.Fatalf("unexpected stats %+v", transfer)
+	}
+}
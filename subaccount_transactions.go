@@ -0,0 +1,99 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// SubaccountTotals is the total of the successful transactions of a subaccount, per currency. It is returned
+// by SumBySubaccount.
+type SubaccountTotals struct {
+	SubaccountCode string
+	// Amount is the total amount paid by customers in the transactions of the subaccount
+	Amount map[string]int64
+	// Share is the total amount the subaccount received from the transactions, i.e. the `subaccount` of
+	// the `fees_split` of the transactions
+	Share map[string]int64
+	Count int
+}
+
+// ForSubaccount lets you list the transactions split with the subaccount with subaccountCode, e.g. to
+// prepare the statement of a vendor on a marketplace. The other queries of TransactionClient.All are
+// also supported.
+//
+// Example:
+//
+//	import (
+//		"context"
+//		p "github.com/gray-adeyi/paystack"
+//	)
+//
+//	txnClient := p.NewTransactionClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// Alternatively, you can access a transaction client from an APIClient
+//	// paystackClient := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// paystackClient.Transactions field is a `TransactionClient`
+//	// Therefore, this is possible
+//	// resp, err := paystackClient.Transactions.ForSubaccount(context.TODO(), "ACCT_8f4k1eq7ml0rlzj")
+//
+//	resp, err := txnClient.ForSubaccount(context.TODO(), "ACCT_8f4k1eq7ml0rlzj",
+//		p.WithQuery("from", "2024-01-01"), p.WithQuery("status", "success"))
+//	if err != nil {
+//		panic(err)
+//	}
+func (t *TransactionClient) ForSubaccount(ctx context.Context, subaccountCode string, queries ...Query) (*Response, error) {
+	queries = append([]Query{WithQuery("subaccount_code", subaccountCode)}, queries...)
+	url := AddQueryParamsToUrl("/transaction", queries...)
+	return t.apiCall(ctx, http.MethodGet, url, nil)
+}
+
+// SumBySubaccount lets you compute the totals of the successful transactions among transactions, e.g. the
+// `data` of the responses of TransactionClient.ForSubaccount, per subaccount. Transactions that are not
+// split with a subaccount are ignored.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	totals, err := p.SumBySubaccount(transactions)
+//	if err != nil {
+//		panic(err)
+//	}
+//	vendor := totals["ACCT_8f4k1eq7ml0rlzj"]
+//	fmt.Println(vendor.Count, vendor.Share["NGN"])
+func SumBySubaccount(transactions []json.RawMessage) (map[string]SubaccountTotals, error) {
+	totals := make(map[string]SubaccountTotals)
+	for _, transaction := range transactions {
+		var t struct {
+			Status     string `json:"status"`
+			Amount     int64  `json:"amount"`
+			Currency   string `json:"currency"`
+			Subaccount struct {
+				SubaccountCode string `json:"subaccount_code"`
+			} `json:"subaccount"`
+			FeesSplit struct {
+				Subaccount int64 `json:"subaccount"`
+			} `json:"fees_split"`
+		}
+		if err := json.Unmarshal(transaction, &t); err != nil {
+			return totals, err
+		}
+		code := t.Subaccount.SubaccountCode
+		if t.Status != "success" || code == "" {
+			continue
+		}
+		subaccount, ok := totals[code]
+		if !ok {
+			subaccount = SubaccountTotals{
+				SubaccountCode: code,
+				Amount:         make(map[string]int64),
+				Share:          make(map[string]int64),
+			}
+		}
+		subaccount.Amount[t.Currency] += t.Amount
+		subaccount.Share[t.Currency] += t.FeesSplit.Subaccount
+		subaccount.Count++
+		totals[code] = subaccount
+	}
+	return totals, nil
+}
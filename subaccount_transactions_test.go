@@ -0,0 +1,50 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestForSubaccount(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.Write([]byte(`{"status":true,"message":"Transactions retrieved","data":[]}`))
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	if _, err := client.Transactions.ForSubaccount(context.Background(), "ACCT_1", WithQuery("status", "success")); err != nil {
+		t.Fatal(err)
+	}
+	if query != "subaccount_code=ACCT_1&status=success" {
+		t.Fatalf("unexpected query %s", query)
+	}
+}
+
+func TestSumBySubaccount(t *testing.T) {
+	transactions := []json.RawMessage{
+		json.RawMessage(`{"status":"success","amount":10000,"currency":"NGN","subaccount":{"subaccount_code":"ACCT_1"},"fees_split":{"subaccount":8000}}`),
+		json.RawMessage(`{"status":"success","amount":20000,"currency":"NGN","subaccount":{"subaccount_code":"ACCT_1"},"fees_split":{"subaccount":16000}}`),
+		json.RawMessage(`{"status":"failed","amount":20000,"currency":"NGN","subaccount":{"subaccount_code":"ACCT_1"},"fees_split":{"subaccount":16000}}`),
+		json.RawMessage(`{"status":"success","amount":5000,"currency":"GHS","subaccount":{"subaccount_code":"ACCT_2"},"fees_split":{"subaccount":4500}}`),
+		json.RawMessage(`{"status":"success","amount":5000,"currency":"NGN","subaccount":{}}`),
+	}
+	totals, err := SumBySubaccount(transactions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(totals) != 2 {
+		t.Fatalf("expected the totals of 2 subaccounts, got %d", len(totals))
+	}
+	vendor := totals["ACCT_1"]
+	if vendor.Count != 2 || vendor.Amount["NGN"] != 30000 || vendor.Share["NGN"] != 24000 {
+		t.Fatalf("unexpected totals %+v", vendor)
+	}
+	if totals["ACCT_2"].Share["GHS"] != 4500 {
+		t.Fatalf("unexpected totals %+v", totals["ACCT_2"])
+	}
+}
@@ -1,6 +1,7 @@
 package paystack
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -291,3 +292,104 @@ func (s *SubscriptionClient) GenerateLink(code string) (*Response, error) {
 func (s *SubscriptionClient) SendLink(code string) (*Response, error) {
 	return s.APICall(http.MethodPost, fmt.Sprintf("/subscription/%s/manage/email/", code), nil)
 }
+
+// EnsureActive lets you create a subscription for a customer on a plan only if the customer does not
+// already have an active subscription on the plan. This prevents duplicate billing when a signup request
+// is retried. If an active, non-renewing or attention subscription exists, it is fetched and returned
+// alongside false. Otherwise, the response of creating the subscription is returned alongside true.
+// If the customer or plan could not be retrieved, the response of retrieving them is returned. If the
+// subscriptions of the customer could not be listed, an error is returned and no subscription is created.
+//
+// The subscription is created with an idempotency key derived from customer and plan. Paystack does not
+// document the IdempotencyKeyHeader, so this is a best-effort guard against concurrent retries of a signup
+// that both find no subscription; serialise the signups of a customer if you must rule out two
+// subscriptions. The key can be replaced by making the call with a context that has a WithIdempotencyKey
+// CallOption e.g. to subscribe a customer to a plan again after cancelling the subscription.
+//
+// Example:
+//
+//	import (
+//		"context"
+//		"fmt"
+//		p "github.com/gray-adeyi/paystack"
+//		"encoding/json"
+//	)
+//
+//	subClient := p.NewSubscriptionClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// Alternatively, you can access a subscription client from an APIClient
+//	// paystackClient := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// paystackClient.Subscriptions field is a `SubscriptionClient`
+//	// Therefore, this is possible
+//	// resp, created, err := paystackClient.Subscriptions.EnsureActive(context.TODO(), "CUS_xnxdt6s1zg1f4nx", "PLN_gx2wn530m0i3w3m", "AUTH_xxx")
+//
+//	resp, created, err := subClient.EnsureActive(context.TODO(), "CUS_xnxdt6s1zg1f4nx", "PLN_gx2wn530m0i3w3m", "AUTH_xxx")
+//	if err != nil {
+//		panic(err)
+//	}
+//	// you can have data be a custom structure based on the data your interested in retrieving from
+//	// from paystack for simplicity, we're using `map[string]interface{}` which is sufficient to
+//	// to serialize the json data returned by paystack
+//	data := make(map[string]interface{})
+//
+//	err := json.Unmarshal(resp.Data, &data); if err != nil {
+//		panic(err)
+//	}
+//	fmt.Println(created, data)
+func (s *SubscriptionClient) EnsureActive(ctx context.Context, customer string, plan string, authorization string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, bool, error) {
+	customerId, resp, err := s.lookupId(ctx, fmt.Sprintf("/customer/%s", customer))
+	if err != nil || customerId == "" {
+		return resp, false, err
+	}
+	planId, resp, err := s.lookupId(ctx, fmt.Sprintf("/plan/%s", plan))
+	if err != nil || planId == "" {
+		return resp, false, err
+	}
+
+	list := func(queries ...Query) (*Response, error) {
+		return s.apiCall(ctx, http.MethodGet, AddQueryParamsToUrl("/subscription", queries...), nil)
+	}
+	var existing string
+	err = forEachPage(list, func(resp *Response) (bool, error) {
+		var subscriptions struct {
+			Data []struct {
+				Status           string `json:"status"`
+				SubscriptionCode string `json:"subscription_code"`
+			} `json:"data"`
+		}
+		if err := resp.Decode(&subscriptions); err != nil {
+			return false, err
+		}
+		for _, subscription := range subscriptions.Data {
+			switch subscription.Status {
+			case "active", "non-renewing", "attention":
+				existing = subscription.SubscriptionCode
+				return false, nil
+			}
+		}
+		return true, nil
+	}, WithQuery("customer", customerId), WithQuery("plan", planId))
+	if err != nil {
+		return nil, false, err
+	}
+	if existing != "" {
+		resp, err = s.apiCall(ctx, http.MethodGet, fmt.Sprintf("/subscription/%s", existing), nil)
+		return resp, false, err
+	}
+
+	payload := map[string]interface{}{
+		"customer":      customer,
+		"plan":          plan,
+		"authorization": authorization,
+	}
+	for _, optionalPayloadParameter := range optionalPayloadParameters {
+		payload = optionalPayloadParameter(payload)
+	}
+	if callOptionsFrom(ctx).idempotencyKey == "" {
+		ctx = WithCallOptions(ctx, WithIdempotencyKey("subscription-"+customer+"-"+plan))
+	}
+	resp, err = s.apiCall(ctx, http.MethodPost, "/subscription", payload)
+	if err != nil {
+		return nil, false, err
+	}
+	return resp, resp.StatusCode < http.StatusBadRequest, nil
+}
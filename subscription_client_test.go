@@ -0,0 +1,105 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestEnsureActive(t *testing.T) {
+	newServer := func(secondPage string, created *[]string) *httptest.Server {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == "/customer/CUS_1":
+				w.Write([]byte(`{"status":true,"data":{"id":101}}`))
+			case r.URL.Path == "/plan/PLN_1":
+				w.Write([]byte(`{"status":true,"data":{"id":202}}`))
+			case r.URL.Path == "/subscription" && r.Method == http.MethodGet:
+				if r.URL.Query().Get("customer") != "101" || r.URL.Query().Get("plan") != "202" {
+					t.Errorf("unexpected filters %v", r.URL.Query())
+				}
+				if r.URL.Query().Get("page") == "1" {
+					w.Write([]byte(`{"status":true,"data":[{"status":"cancelled","subscription_code":"SUB_old"}],
+						"meta":{"page":1,"pageCount":2}}`))
+					return
+				}
+				w.Write([]byte(`{"status":true,"data":[` + secondPage + `],"meta":{"page":2,"pageCount":2}}`))
+			case r.URL.Path == "/subscription/SUB_active":
+				w.Write([]byte(`{"status":true,"data":{"subscription_code":"SUB_active"}}`))
+			case r.URL.Path == "/subscription" && r.Method == http.MethodPost:
+				*created = append(*created, r.Header.Get(IdempotencyKeyHeader))
+				w.Write([]byte(`{"status":true,"data":{"subscription_code":"SUB_new"}}`))
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			}
+		}))
+		t.Cleanup(server.Close)
+		return server
+	}
+
+	t.Run("existing subscription on a later page", func(t *testing.T) {
+		var created []string
+		server := newServer(`{"status":"active","subscription_code":"SUB_active"}`, &created)
+		client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+		resp, ok, err := client.Subscriptions.EnsureActive(context.Background(), "CUS_1", "PLN_1", "AUTH_1")
+		if err != nil || ok || len(created) != 0 {
+			t.Fatalf("expected the existing subscription, got %v %v %v", ok, err, created)
+		}
+		if resp.Endpoint() != "GET /subscription/SUB_active" {
+			t.Fatalf("expected the existing subscription to be fetched, got %s", resp.Endpoint())
+		}
+	})
+
+	t.Run("create with a deterministic idempotency key", func(t *testing.T) {
+		var created []string
+		server := newServer(`{"status":"complete","subscription_code":"SUB_done"}`, &created)
+		client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+		for i := 0; i < 2; i++ {
+			_, ok, err := client.Subscriptions.EnsureActive(context.Background(), "CUS_1", "PLN_1", "AUTH_1")
+			if err != nil || !ok {
+				t.Fatalf("expected a subscription to be created, got %v %v", ok, err)
+			}
+		}
+		if len(created) != 2 || created[0] == "" || created[0] != created[1] {
+			t.Fatalf("expected retries to share an idempotency key, got %v", created)
+		}
+	})
+
+	t.Run("idempotency key of the caller", func(t *testing.T) {
+		var created []string
+		server := newServer(`{"status":"complete","subscription_code":"SUB_done"}`, &created)
+		client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+		ctx := WithCallOptions(context.Background(), WithIdempotencyKey("resubscribe-1"))
+		if _, _, err := client.Subscriptions.EnsureActive(ctx, "CUS_1", "PLN_1", "AUTH_1"); err != nil {
+			t.Fatal(err)
+		}
+		if len(created) != 1 || created[0] != "resubscribe-1" {
+			t.Fatalf("expected the idempotency key of the caller, got %v", created)
+		}
+	})
+
+	t.Run("failed subscription list", func(t *testing.T) {
+		var created int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == "/customer/CUS_1":
+				w.Write([]byte(`{"status":true,"data":{"id":101}}`))
+			case r.URL.Path == "/plan/PLN_1":
+				w.Write([]byte(`{"status":true,"data":{"id":202}}`))
+			case r.URL.Path == "/subscription" && r.Method == http.MethodGet:
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(`{"status":false,"message":"Something went wrong"}`))
+			default:
+				created++
+			}
+		}))
+		defer server.Close()
+		client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+		_, ok, err := client.Subscriptions.EnsureActive(context.Background(), "CUS_1", "PLN_1", "AUTH_1")
+		if !errors.Is(err, ErrServer) || ok || created != 0 {
+			t.Fatalf("expected the failed list to be returned without creating a subscription, got %v %v %d", ok, err, created)
+		}
+	})
+}
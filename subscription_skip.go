@@ -0,0 +1,158 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// planIntervalAfter returns the billing date after t for a plan of the provided interval
+func planIntervalAfter(t time.Time, interval string) (time.Time, error) {
+	switch interval {
+	case "hourly":
+		return t.Add(time.Hour), nil
+	case "daily":
+		return t.AddDate(0, 0, 1), nil
+	case "weekly":
+		return t.AddDate(0, 0, 7), nil
+	case "monthly":
+		return t.AddDate(0, 1, 0), nil
+	case "quarterly":
+		return t.AddDate(0, 3, 0), nil
+	case "biannually":
+		return t.AddDate(0, 6, 0), nil
+	case "annually":
+		return t.AddDate(1, 0, 0), nil
+	}
+	return time.Time{}, fmt.Errorf("unsupported plan interval %q", interval)
+}
+
+// The steps of skipping the next billing of a subscription with SubscriptionClient.SkipNextBilling
+const (
+	SkipBillingStepDisable = "disable"
+	SkipBillingStepCreate  = "create"
+)
+
+// SkipBillingError is returned by SubscriptionClient.SkipNextBilling when a step of skipping the next billing
+// of a subscription failed. When Disabled is true, the original subscription was disabled and could not be
+// enabled again after the replacement subscription failed, so the customer is not subscribed; enable
+// SubscriptionCode with SubscriptionClient.Enable, or call SkipNextBilling again once the cause is fixed.
+type SkipBillingError struct {
+	// Step is the step that failed, either SkipBillingStepDisable or SkipBillingStepCreate
+	Step string
+	// SubscriptionCode is the code of the original subscription
+	SubscriptionCode string
+	// Disabled reports whether the original subscription was left disabled
+	Disabled bool
+	// Err is the error of the step, joined with the error of enabling the original subscription again if
+	// Disabled is true
+	Err error
+}
+
+func (e *SkipBillingError) Error() string {
+	message := fmt.Sprintf("unable to skip the next billing of subscription %s: %s step failed", e.SubscriptionCode, e.Step)
+	if e.Disabled {
+		message += ", the subscription was left disabled"
+	}
+	return fmt.Sprintf("%s: %v", message, e.Err)
+}
+
+func (e *SkipBillingError) Unwrap() error {
+	return e.Err
+}
+
+// SkipNextBilling lets you skip the next billing of a subscription. Paystack does not support pausing a
+// subscription, so the subscription is disabled and replaced by a subscription to the same plan with the
+// same authorization whose `start_date` is the billing date after the skipped one. If the replacement
+// subscription could not be created, the original subscription is enabled again, even if ctx is done.
+//
+// The response of creating the replacement subscription is returned. Take note that the replacement has
+// a different subscription code from the original subscription. If disabling the subscription or creating
+// the replacement failed, a *SkipBillingError reporting the step and whether the original subscription was
+// left disabled is returned alongside the response of the failed step, if paystack responded.
+//
+// Example:
+//
+//	import (
+//		"context"
+//		p "github.com/gray-adeyi/paystack"
+//	)
+//
+//	subClient := p.NewSubscriptionClient(p.WithSecretKey("<paystack-secret-key>"))
+//	resp, err := subClient.SkipNextBilling(context.TODO(), "SUB_vsyqdmlzble3uii")
+//	var skipErr *p.SkipBillingError
+//	if errors.As(err, &skipErr) && skipErr.Disabled {
+//		// the customer is not subscribed, enable skipErr.SubscriptionCode
+//	}
+func (s *SubscriptionClient) SkipNextBilling(ctx context.Context, code string) (*Response, error) {
+	resp, err := s.apiCall(ctx, http.MethodGet, fmt.Sprintf("/subscription/%s", code), nil)
+	if err != nil {
+		return nil, err
+	}
+	var subscription struct {
+		Status  bool   `json:"status"`
+		Message string `json:"message"`
+		Data    struct {
+			Status          string     `json:"status"`
+			EmailToken      string     `json:"email_token"`
+			NextPaymentDate *time.Time `json:"next_payment_date"`
+			Customer        struct {
+				CustomerCode string `json:"customer_code"`
+			} `json:"customer"`
+			Plan struct {
+				PlanCode string `json:"plan_code"`
+				Interval string `json:"interval"`
+			} `json:"plan"`
+			Authorization struct {
+				AuthorizationCode string `json:"authorization_code"`
+			} `json:"authorization"`
+		} `json:"data"`
+	}
+	if err = resp.Decode(&subscription); err != nil {
+		return nil, err
+	}
+	if !subscription.Status {
+		return resp, nil
+	}
+	data := subscription.Data
+	if data.Status != "active" || data.NextPaymentDate == nil {
+		return nil, fmt.Errorf("subscription %s has no upcoming billing to skip", code)
+	}
+	startDate, err := planIntervalAfter(*data.NextPaymentDate, data.Plan.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	token := map[string]interface{}{"code": code, "token": data.EmailToken}
+	resp, err = s.apiCall(ctx, http.MethodPost, "/subscription/disable", token)
+	if err = failedStep(resp, err); err != nil {
+		return responseOf(resp, err), &SkipBillingError{Step: SkipBillingStepDisable, SubscriptionCode: code, Err: err}
+	}
+	resp, err = s.apiCall(ctx, http.MethodPost, "/subscription", map[string]interface{}{
+		"customer":      data.Customer.CustomerCode,
+		"plan":          data.Plan.PlanCode,
+		"authorization": data.Authorization.AuthorizationCode,
+		"start_date":    startDate.UTC().Format(time.RFC3339),
+	})
+	if err = failedStep(resp, err); err != nil {
+		skipErr := &SkipBillingError{Step: SkipBillingStepCreate, SubscriptionCode: code, Err: err}
+		// the customer must not be left unsubscribed because the caller gave up
+		enabled, enableErr := s.apiCall(context.WithoutCancel(ctx), http.MethodPost, "/subscription/enable", token)
+		if enableErr = failedStep(enabled, enableErr); enableErr != nil {
+			skipErr.Disabled = true
+			skipErr.Err = errors.Join(err, fmt.Errorf("unable to enable subscription %s: %w", code, enableErr))
+		}
+		return responseOf(resp, err), skipErr
+	}
+	return resp, nil
+}
+
+// failedStep returns the error of a call, or the *APIError of its response if paystack failed it
+func failedStep(resp *Response, err error) error {
+	if err != nil {
+		return err
+	}
+	return resp.AsError()
+}
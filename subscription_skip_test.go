@@ -0,0 +1,123 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newSkipBillingServer returns a server for skipping the next billing of SUB_1 that fails the steps in fail,
+// recording the steps it received
+func newSkipBillingServer(t *testing.T, steps *[]string, fail ...string) *httptest.Server {
+	failed := make(map[string]bool)
+	for _, step := range fail {
+		failed[step] = true
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		var step string
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/subscription/SUB_1":
+			w.Write([]byte(`{"status":true,"data":{"status":"active","email_token":"tok_1",
+				"next_payment_date":"2024-01-15T00:00:00Z","customer":{"customer_code":"CUS_1"},
+				"plan":{"plan_code":"PLN_1","interval":"monthly"},"authorization":{"authorization_code":"AUTH_1"}}}`))
+			return
+		case r.URL.Path == "/subscription/disable":
+			step = "disable " + payload["code"].(string)
+		case r.URL.Path == "/subscription/enable":
+			step = "enable " + payload["code"].(string)
+		case r.Method == http.MethodPost && r.URL.Path == "/subscription":
+			step = "create " + payload["plan"].(string) + " from " + payload["start_date"].(string)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			return
+		}
+		*steps = append(*steps, step)
+		if failed[strings.Fields(step)[0]] {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"status":false,"message":"Request failed"}`))
+			return
+		}
+		w.Write([]byte(`{"status":true,"data":{"subscription_code":"SUB_2"}}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSkipNextBilling(t *testing.T) {
+	var steps []string
+	server := newSkipBillingServer(t, &steps)
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	resp, err := client.Subscriptions.SkipNextBilling(context.Background(), "SUB_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "disable SUB_1|create PLN_1 from 2024-02-15T00:00:00Z"; strings.Join(steps, "|") != want {
+		t.Fatalf("expected %s, got %v", want, steps)
+	}
+	if resp.Endpoint() != "POST /subscription" {
+		t.Fatalf("expected the response of the replacement, got %s", resp.Endpoint())
+	}
+}
+
+func TestSkipNextBillingFailedSteps(t *testing.T) {
+	tests := []struct {
+		name     string
+		fail     []string
+		step     string
+		disabled bool
+		steps    string
+	}{
+		{
+			name:  "disable",
+			fail:  []string{"disable"},
+			step:  SkipBillingStepDisable,
+			steps: "disable SUB_1",
+		},
+		{
+			name:  "create",
+			fail:  []string{"create"},
+			step:  SkipBillingStepCreate,
+			steps: "disable SUB_1|create PLN_1 from 2024-02-15T00:00:00Z|enable SUB_1",
+		},
+		{
+			name:     "create and enable",
+			fail:     []string{"create", "enable"},
+			step:     SkipBillingStepCreate,
+			disabled: true,
+			steps:    "disable SUB_1|create PLN_1 from 2024-02-15T00:00:00Z|enable SUB_1",
+		},
+	}
+	for _, tt := range tests {
+		for _, strict := range []bool{false, true} {
+			var steps []string
+			server := newSkipBillingServer(t, &steps, tt.fail...)
+			options := []ClientOptions{WithSecretKey("sk_test"), WithBaseUrl(server.URL)}
+			if strict {
+				options = append(options, WithStrictErrors())
+			}
+			client := NewAPIClient(options...)
+
+			resp, err := client.Subscriptions.SkipNextBilling(context.Background(), "SUB_1")
+			var skipErr *SkipBillingError
+			if !errors.As(err, &skipErr) {
+				t.Fatalf("%s, strict %v: expected a *SkipBillingError, got %v", tt.name, strict, err)
+			}
+			if skipErr.Step != tt.step || skipErr.Disabled != tt.disabled || skipErr.SubscriptionCode != "SUB_1" {
+				t.Errorf("%s, strict %v: unexpected error %+v", tt.name, strict, skipErr)
+			}
+			if !errors.Is(err, ErrValidation) || resp == nil || resp.StatusCode != http.StatusBadRequest {
+				t.Errorf("%s, strict %v: expected the failed response, got %v %v", tt.name, strict, resp, err)
+			}
+			if got := strings.Join(steps, "|"); got != tt.steps {
+				t.Errorf("%s, strict %v: expected %s, got %s", tt.name, strict, tt.steps, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// SyncResource specifies the resources that can be synced by a Syncer
+type SyncResource = string
+
+const SyncResourceTransactions SyncResource = "transactions"
+const SyncResourceCustomers SyncResource = "customers"
+const SyncResourceTransfers SyncResource = "transfers"
+
+// CursorStore is implemented by types that persist the cursor of each resource synced by a Syncer. The
+// cursor of a resource is the creation time of the most recent record of the resource that was synced.
+// The resource a cursor is saved for is prefixed with the Domain of the synced records e.g.
+// `test/transactions`.
+type CursorStore interface {
+	// LoadCursor should return the zero time.Time if a cursor has not been saved for the resource
+	LoadCursor(resource SyncResource) (time.Time, error)
+	SaveCursor(resource SyncResource, cursor time.Time) error
+}
+
+// MemoryCursorStore is a CursorStore that keeps cursors in memory
+type MemoryCursorStore struct {
+	mu      sync.RWMutex
+	cursors map[SyncResource]time.Time
+}
+
+// NewMemoryCursorStore creates a MemoryCursorStore
+func NewMemoryCursorStore() *MemoryCursorStore {
+	return &MemoryCursorStore{cursors: make(map[SyncResource]time.Time)}
+}
+
+func (m *MemoryCursorStore) LoadCursor(resource SyncResource) (time.Time, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.cursors[resource], nil
+}
+
+func (m *MemoryCursorStore) SaveCursor(resource SyncResource, cursor time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cursors[resource] = cursor
+	return nil
+}
+
+// SyncHandlerFunc is a function that processes a record retrieved by a Syncer. record is the raw JSON
+// of the record as returned by paystack.
+type SyncHandlerFunc = func(resource SyncResource, record json.RawMessage) error
+
+// Syncer incrementally pulls records of your Integration's resources (transactions, customers and
+// transfers) into your handlers, e.g. to load them into a data warehouse. The cursor of each resource is
+// persisted in a CursorStore so that each sync only retrieves the records created since the last sync.
+// Cursors are namespaced by the Domain of the secret key of the client e.g. `live/transactions`, and a
+// record whose `domain` differs from the Domain of the secret key fails the sync with ErrDomainMismatch,
+// so that test and live data are never mixed in the same store namespace.
+// Records are delivered at least once: the cursor of a resource is only advanced when all its records
+// were processed successfully, so handlers should be idempotent. It should not be instantiated directly
+// but via the NewSyncer function.
+//
+// Example
+//
+//	import (
+//		"context"
+//		p "github.com/gray-adeyi/paystack"
+//	)
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	syncer := p.NewSyncer(client, p.NewMemoryCursorStore())
+//	syncer.Handle(p.SyncResourceTransactions, func(resource p.SyncResource, record json.RawMessage) error {
+//		return warehouse.Insert(resource, record)
+//	})
+//	if err := syncer.SyncOnce(context.TODO()); err != nil {
+//		panic(err)
+//	}
+type Syncer struct {
+	client   *APIClient
+	store    CursorStore
+	mu       sync.Mutex
+	handlers map[SyncResource]SyncHandlerFunc
+}
+
+// NewSyncer creates a Syncer. If store is nil, a MemoryCursorStore is used.
+func NewSyncer(client *APIClient, store CursorStore) *Syncer {
+	if store == nil {
+		store = NewMemoryCursorStore()
+	}
+	return &Syncer{client: client, store: store, handlers: make(map[SyncResource]SyncHandlerFunc)}
+}
+
+// Handle lets you register the function that processes the records of a resource. Only resources with
+// a registered function are synced.
+func (s *Syncer) Handle(resource SyncResource, handlerFunc SyncHandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[resource] = handlerFunc
+}
+
+// SyncOnce lets you retrieve the records created since the last sync of every resource with a registered
+// function and pass them to the function. Syncing stops at the first error.
+func (s *Syncer) SyncOnce(ctx context.Context) error {
+	s.mu.Lock()
+	resources := make([]SyncResource, 0, len(s.handlers))
+	for resource := range s.handlers {
+		resources = append(resources, resource)
+	}
+	s.mu.Unlock()
+	sort.Strings(resources)
+
+	for _, resource := range resources {
+		if err := s.syncResource(ctx, resource); err != nil {
+			return fmt.Errorf("unable to sync %s: %w", resource, err)
+		}
+	}
+	return nil
+}
+
+func (s *Syncer) syncResource(ctx context.Context, resource SyncResource) error {
+	s.mu.Lock()
+	handlerFunc := s.handlers[resource]
+	s.mu.Unlock()
+	list, err := s.listFunc(resource)
+	if err != nil {
+		return err
+	}
+	secretKey, _, err := s.client.secretKeys(ctx)
+	if err != nil {
+		return err
+	}
+	domain := DomainFromSecretKey(secretKey)
+	namespace := cursorNamespace(domain, resource)
+	cursor, err := s.store.LoadCursor(namespace)
+	if err != nil {
+		return err
+	}
+
+	queries := DateRange(cursor, time.Time{})
+	latest := cursor
+	err = forEachPage(list, func(resp *Response) (bool, error) {
+		var page struct {
+			Status  bool              `json:"status"`
+			Message string            `json:"message"`
+			Data    []json.RawMessage `json:"data"`
+		}
+		if err := resp.Decode(&page); err != nil {
+			return false, err
+		}
+		if !page.Status {
+			return false, fmt.Errorf("unable to list %s: %s", resource, page.Message)
+		}
+		for _, record := range page.Data {
+			if err := ctx.Err(); err != nil {
+				return false, err
+			}
+			if recordDomain := RecordDomain(record); domain != "" && recordDomain != "" && recordDomain != domain {
+				return false, fmt.Errorf("%w: %s record in the %s namespace", ErrDomainMismatch, recordDomain, namespace)
+			}
+			createdAt := recordCreatedAt(record)
+			// records without a valid creation time are delivered rather than risk skipping them
+			if !createdAt.IsZero() && createdAt.Before(cursor) {
+				continue
+			}
+			err := callSafely("sync handler for "+resource, s.client.panicPolicy, func() error {
+				return handlerFunc(resource, record)
+			})
+			if err != nil {
+				return false, err
+			}
+			if createdAt.After(latest) {
+				latest = createdAt
+			}
+		}
+		return true, nil
+	}, queries...)
+	if err != nil {
+		return err
+	}
+	if latest.After(cursor) {
+		return s.store.SaveCursor(namespace, latest)
+	}
+	return nil
+}
+
+// cursorNamespace returns the key the cursor of resource is persisted under for domain
+func cursorNamespace(domain Domain, resource SyncResource) SyncResource {
+	if domain == "" {
+		return resource
+	}
+	return domain + "/" + resource
+}
+
+func (s *Syncer) listFunc(resource SyncResource) (ListFunc, error) {
+	switch resource {
+	case SyncResourceTransactions:
+		return s.client.Transactions.All, nil
+	case SyncResourceCustomers:
+		return s.client.Customers.All, nil
+	case SyncResourceTransfers:
+		return s.client.Transfers.All, nil
+	}
+	return nil, fmt.Errorf("unsupported sync resource %q", resource)
+}
+
+// recordCreatedAt retrieves the creation time of a record. The zero time.Time is returned if the record
+// does not have a valid creation time.
+func recordCreatedAt(record json.RawMessage) time.Time {
+	var timestamps struct {
+		CreatedAt      *time.Time `json:"createdAt"`
+		CreatedAtSnake *time.Time `json:"created_at"`
+	}
+	if err := json.Unmarshal(record, &timestamps); err != nil {
+		return time.Time{}
+	}
+	if timestamps.CreatedAt != nil {
+		return *timestamps.CreatedAt
+	}
+	if timestamps.CreatedAtSnake != nil {
+		return *timestamps.CreatedAtSnake
+	}
+	return time.Time{}
+}
@@ -0,0 +1,158 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newSyncServer returns a server that lists pages of transactions, recording the `from` query of each request
+func newSyncServer(t *testing.T, pages [][]string, froms *[]string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/transaction" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			return
+		}
+		*froms = append(*froms, r.URL.Query().Get("from"))
+		var page int
+		fmt.Sscan(r.URL.Query().Get("page"), &page)
+		if page < 1 || page > len(pages) {
+			t.Errorf("unexpected page %d", page)
+			return
+		}
+		var records []json.RawMessage
+		for _, record := range pages[page-1] {
+			records = append(records, json.RawMessage(record))
+		}
+		data, _ := json.Marshal(records)
+		fmt.Fprintf(w, `{"status":true,"data":%s,"meta":{"page":%d,"pageCount":%d}}`, data, page, len(pages))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func syncRecord(id int, domain string, createdAt time.Time) string {
+	return fmt.Sprintf(`{"id":%d,"domain":%q,"createdAt":%q}`, id, domain, createdAt.Format(time.RFC3339))
+}
+
+func TestSyncerPersistsCursor(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var froms []string
+	server := newSyncServer(t, [][]string{
+		{syncRecord(1, DomainTest, base), syncRecord(2, DomainTest, base.Add(time.Hour))},
+		{syncRecord(3, DomainTest, base.Add(2*time.Hour))},
+	}, &froms)
+	store := NewMemoryCursorStore()
+	client := NewAPIClient(WithSecretKey("sk_test_key"), WithBaseUrl(server.URL))
+	syncer := NewSyncer(client, store)
+	var ids []int
+	syncer.Handle(SyncResourceTransactions, func(resource SyncResource, record json.RawMessage) error {
+		var r struct{ Id int }
+		json.Unmarshal(record, &r)
+		ids = append(ids, r.Id)
+		return nil
+	})
+
+	if err := syncer.SyncOnce(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(ids) != "[1 2 3]" {
+		t.Fatalf("expected the records of every page to be synced, got %v", ids)
+	}
+	if len(froms) != 2 || froms[0] != "" {
+		t.Fatalf("expected 2 pages to be listed without a cursor, got %q", froms)
+	}
+	cursor, _ := store.LoadCursor("test/transactions")
+	if !cursor.Equal(base.Add(2 * time.Hour)) {
+		t.Fatalf("expected the cursor to be saved in the test namespace, got %s", cursor)
+	}
+	if cursor, _ := store.LoadCursor(SyncResourceTransactions); !cursor.IsZero() {
+		t.Fatalf("expected no cursor outside the test namespace, got %s", cursor)
+	}
+
+	froms = nil
+	if err := syncer.SyncOnce(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(froms) == 0 || froms[0] != formatQueryTime(base.Add(2*time.Hour)) {
+		t.Fatalf("expected the next sync to list from the cursor, got %q", froms)
+	}
+}
+
+func TestSyncerRedeliversRecordsAtCursor(t *testing.T) {
+	cursor := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	var froms []string
+	server := newSyncServer(t, [][]string{{
+		syncRecord(1, DomainTest, cursor.Add(-time.Hour)),
+		syncRecord(2, DomainTest, cursor),
+		syncRecord(3, DomainTest, cursor.Add(time.Hour)),
+	}}, &froms)
+	store := NewMemoryCursorStore()
+	store.SaveCursor("test/transactions", cursor)
+	client := NewAPIClient(WithSecretKey("sk_test_key"), WithBaseUrl(server.URL))
+	syncer := NewSyncer(client, store)
+	var ids []int
+	failed := errors.New("warehouse unavailable")
+	fail := true
+	syncer.Handle(SyncResourceTransactions, func(resource SyncResource, record json.RawMessage) error {
+		var r struct{ Id int }
+		json.Unmarshal(record, &r)
+		ids = append(ids, r.Id)
+		if r.Id == 3 && fail {
+			return failed
+		}
+		return nil
+	})
+
+	if err := syncer.SyncOnce(context.Background()); !errors.Is(err, failed) {
+		t.Fatalf("expected the handler's error, got %v", err)
+	}
+	if saved, _ := store.LoadCursor("test/transactions"); !saved.Equal(cursor) {
+		t.Fatalf("expected the cursor not to advance when a record fails, got %s", saved)
+	}
+
+	fail = false
+	if err := syncer.SyncOnce(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(ids) != "[2 3 2 3]" {
+		t.Fatalf("expected the records at and after the cursor to be redelivered, got %v", ids)
+	}
+	if saved, _ := store.LoadCursor("test/transactions"); !saved.Equal(cursor.Add(time.Hour)) {
+		t.Fatalf("expected the cursor to advance, got %s", saved)
+	}
+}
+
+func TestSyncerDomainMismatchOnLaterPage(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var froms []string
+	server := newSyncServer(t, [][]string{
+		{syncRecord(1, DomainLive, base)},
+		{syncRecord(2, DomainTest, base.Add(time.Hour))},
+	}, &froms)
+	store := NewMemoryCursorStore()
+	client := NewAPIClient(WithSecretKey("sk_live_key"), WithBaseUrl(server.URL))
+	syncer := NewSyncer(client, store)
+	var ids []int
+	syncer.Handle(SyncResourceTransactions, func(resource SyncResource, record json.RawMessage) error {
+		var r struct{ Id int }
+		json.Unmarshal(record, &r)
+		ids = append(ids, r.Id)
+		return nil
+	})
+
+	if err := syncer.SyncOnce(context.Background()); !errors.Is(err, ErrDomainMismatch) {
+		t.Fatalf("expected %v, got %v", ErrDomainMismatch, err)
+	}
+	if fmt.Sprint(ids) != "[1]" {
+		t.Fatalf("expected only the live record to be handled, got %v", ids)
+	}
+	if cursor, _ := store.LoadCursor("live/transactions"); !cursor.IsZero() {
+		t.Fatalf("expected the cursor not to be saved when a sync fails, got %s", cursor)
+	}
+}
@@ -0,0 +1,141 @@
+package paystack
+
+import (
+	"encoding/json"
+)
+
+// TagsMetadataKey is the key of the `metadata` of a resource, e.g. a transfer or transfer recipient, the
+// tags added with Tag or WithTags are stored in
+const TagsMetadataKey = "sdk_tags"
+
+// Tag lets you add tags to metadata, e.g. the `metadata` of an item of the batch of
+// TransferClient.BulkInitiate, so that payouts can be grouped and later filtered with HasTag or
+// FilterByTag. The tags are stored as an array under TagsMetadataKey and tags already present are not
+// duplicated. A new metadata is created if metadata is nil.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	resp, err := client.Transfers.BulkInitiate("balance", []map[string]interface{}{
+//		{"amount": 20000, "recipient": "RCP_2tn9clt23s7qr28", "reference": "payroll-1",
+//			"metadata": p.Tag(nil, "payroll", "2024-07")},
+//	})
+func Tag(metadata map[string]interface{}, tags ...string) map[string]interface{} {
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+	}
+	existing := tagsOf(metadata[TagsMetadataKey])
+	seen := make(map[string]bool, len(existing))
+	for _, tag := range existing {
+		seen[tag] = true
+	}
+	for _, tag := range tags {
+		if !seen[tag] {
+			seen[tag] = true
+			existing = append(existing, tag)
+		}
+	}
+	metadata[TagsMetadataKey] = existing
+	return metadata
+}
+
+// WithTags lets you add tags to the `metadata` of a payload e.g. when creating a transfer recipient with
+// TransferRecipientClient.Create or initiating a transfer with TransferClient.Initiate. Other keys of the
+// `metadata`, whether provided as a map or a JSON string with WithOptionalParameter, are preserved.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	resp, err := client.TransferRecipients.Create(p.RecipientTypeNuban, "Tolu Robert", "01000000010", "058",
+//		p.WithTags("vendor", "lagos"))
+func WithTags(tags ...string) OptionalPayloadParameter {
+	return func(m map[string]interface{}) map[string]interface{} {
+		metadata := make(map[string]interface{})
+		switch value := m["metadata"].(type) {
+		case map[string]interface{}:
+			metadata = value
+		case string:
+			_ = json.Unmarshal([]byte(value), &metadata)
+		}
+		m["metadata"] = Tag(metadata, tags...)
+		return m
+	}
+}
+
+// Tags returns the tags of resource, the JSON of a resource e.g. an item of the `data` of the response
+// of TransferClient.All. The `metadata` of the resource may be an object or a JSON string.
+func Tags(resource json.RawMessage) []string {
+	var r struct {
+		Metadata json.RawMessage `json:"metadata"`
+	}
+	if err := json.Unmarshal(resource, &r); err != nil {
+		return nil
+	}
+	metadata := make(map[string]interface{})
+	if err := json.Unmarshal(r.Metadata, &metadata); err != nil {
+		// paystack returns metadata provided as a JSON string as is
+		var encoded string
+		if err = json.Unmarshal(r.Metadata, &encoded); err != nil {
+			return nil
+		}
+		if err = json.Unmarshal([]byte(encoded), &metadata); err != nil {
+			return nil
+		}
+	}
+	return tagsOf(metadata[TagsMetadataKey])
+}
+
+// HasTag checks if resource, the JSON of a resource e.g. a transfer or transfer recipient, has tag
+func HasTag(resource json.RawMessage, tag string) bool {
+	for _, t := range Tags(resource) {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterByTag lets you retrieve the resources among resources, e.g. the `data` of the response of
+// TransferClient.All, that have tag.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	var transfers struct {
+//		Data []json.RawMessage `json:"data"`
+//	}
+//	if err = resp.Decode(&transfers); err != nil {
+//		panic(err)
+//	}
+//	payroll := p.FilterByTag(transfers.Data, "payroll")
+func FilterByTag(resources []json.RawMessage, tag string) []json.RawMessage {
+	var filtered []json.RawMessage
+	for _, resource := range resources {
+		if HasTag(resource, tag) {
+			filtered = append(filtered, resource)
+		}
+	}
+	return filtered
+}
+
+// tagsOf converts the value stored under TagsMetadataKey to a slice of tags
+func tagsOf(value interface{}) []string {
+	switch tags := value.(type) {
+	case []string:
+		return append([]string(nil), tags...)
+	case []interface{}:
+		var converted []string
+		for _, tag := range tags {
+			if s, ok := tag.(string); ok {
+				converted = append(converted, s)
+			}
+		}
+		return converted
+	}
+	return nil
+}
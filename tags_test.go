@@ -0,0 +1,39 @@
+package paystack
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestTag(t *testing.T) {
+	metadata := Tag(map[string]interface{}{"batch": 7}, "payroll", "2024-07")
+	metadata = Tag(metadata, "payroll", "lagos")
+	if got := tagsOf(metadata[TagsMetadataKey]); !reflect.DeepEqual(got, []string{"payroll", "2024-07", "lagos"}) {
+		t.Fatalf("unexpected tags %v", got)
+	}
+	if metadata["batch"] != 7 {
+		t.Fatal("expected other keys of the metadata to be preserved")
+	}
+
+	payload := WithTags("vendor")(map[string]interface{}{"metadata": `{"sdk_tags":["lagos"],"vendor_id":1}`})
+	encoded, _ := json.Marshal(payload)
+	if !HasTag(encoded, "vendor") || !HasTag(encoded, "lagos") {
+		t.Fatalf("expected the tags to be merged into the metadata, got %s", encoded)
+	}
+}
+
+func TestFilterByTag(t *testing.T) {
+	transfers := []json.RawMessage{
+		json.RawMessage(`{"reference":"1","metadata":{"sdk_tags":["payroll"]}}`),
+		json.RawMessage(`{"reference":"2","metadata":"{\"sdk_tags\":[\"payroll\",\"bonus\"]}"}`),
+		json.RawMessage(`{"reference":"3","metadata":null}`),
+		json.RawMessage(`{"reference":"4","metadata":{"sdk_tags":["refund"]}}`),
+	}
+	if got := len(FilterByTag(transfers, "payroll")); got != 2 {
+		t.Fatalf("expected 2 payroll transfers, got %d", got)
+	}
+	if got := Tags(transfers[1]); !reflect.DeepEqual(got, []string{"payroll", "bonus"}) {
+		t.Fatalf("unexpected tags %v", got)
+	}
+}
@@ -1,8 +1,13 @@
 package paystack
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 // TerminalEvent specifies the supported terminal event by paystack
@@ -11,6 +16,17 @@ type TerminalEvent = string
 const TerminalEventInvoice TerminalEvent = "invoice"
 const TerminalEventTransaction TerminalEvent = "transaction"
 
+// terminalEventPollInterval is how often the status of an event sent to a Terminal is checked
+var terminalEventPollInterval = 2 * time.Second
+
+// terminalEventMaxPolls is how many times the status of an event sent to a Terminal is checked before
+// giving up on its delivery
+var terminalEventMaxPolls = 30
+
+// ErrTerminalEventNotDelivered is returned by TerminalClient.PrintReceipt when the Terminal did not receive
+// the event after its status was checked for about a minute e.g. because the Terminal is offline.
+var ErrTerminalEventNotDelivered = errors.New("the event was not delivered to the terminal")
+
 // TerminalClient interacts with endpoints related to paystack Terminal resource that allows you to
 // build delightful in-person payment experiences.
 type TerminalClient struct {
@@ -108,6 +124,102 @@ func (t *TerminalClient) EventStatus(terminalId string, eventId string) (*Respon
 	return t.APICall(http.MethodGet, fmt.Sprintf("/terminal/%s/event/%s", terminalId, eventId), nil)
 }
 
+// PrintReceipt lets you print the receipt of a transaction on a Terminal. It sends a `transaction` event
+// with the `print` action to the Terminal and polls the status of the event until it is delivered to the
+// Terminal, ctx is done or the status could not be checked. The response of the last event status check is
+// returned. If the event could not be sent, the response of sending the event is returned instead. The
+// response of the last check is returned alongside ErrTerminalEventNotDelivered if the event is still not
+// delivered after about a minute.
+//
+// Example:
+//
+//	import (
+//		"context"
+//		"fmt"
+//		"time"
+//		p "github.com/gray-adeyi/paystack"
+//		"encoding/json"
+//	)
+//
+//	terminalClient := p.NewTerminalClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// Alternatively, you can access a terminal client from an APIClient
+//	// paystackClient := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// paystackClient.Terminals field is a `TerminalClient`
+//	// Therefore, this is possible
+//	// resp, err := paystackClient.Terminals.PrintReceipt(ctx, "30", "616d721e8c5cd40a0cdd54a6")
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+//	defer cancel()
+//	resp, err := terminalClient.PrintReceipt(ctx, "30", "2953512344")
+//	if err != nil {
+//		panic(err)
+//	}
+//	// you can have data be a custom structure based on the data your interested in retrieving from
+//	// from paystack for simplicity, we're using `map[string]interface{}` which is sufficient to
+//	// to serialize the json data returned by paystack
+//	data := make(map[string]interface{})
+//
+//	err := json.Unmarshal(resp.Data, &data); if err != nil {
+//		panic(err)
+//	}
+//	fmt.Println(data)
+func (t *TerminalClient) PrintReceipt(ctx context.Context, terminalId string, transactionId string) (*Response, error) {
+	if terminalId == "" {
+		return nil, errors.New("a terminal id is required to print a receipt")
+	}
+	if _, err := strconv.ParseInt(transactionId, 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid transaction id %q: a transaction id is numeric", transactionId)
+	}
+
+	resp, err := t.apiCall(ctx, http.MethodPost, fmt.Sprintf("/terminal/%s/event", terminalId), map[string]interface{}{
+		"type":   TerminalEventTransaction,
+		"action": "print",
+		"data":   map[string]interface{}{"id": transactionId},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var event struct {
+		Data struct {
+			Id string `json:"id"`
+		} `json:"data"`
+	}
+	if err = json.Unmarshal(resp.Data, &event); err != nil || event.Data.Id == "" {
+		return resp, nil
+	}
+
+	ticker := time.NewTicker(terminalEventPollInterval)
+	defer ticker.Stop()
+	for polls := 0; polls < terminalEventMaxPolls; polls++ {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+		resp, err = t.apiCall(ctx, http.MethodGet, fmt.Sprintf("/terminal/%s/event/%s", terminalId, event.Data.Id), nil)
+		if err != nil {
+			return nil, err
+		}
+		// the status of an event that can not be checked, e.g. an unknown event, will not change
+		if resp.StatusCode >= http.StatusMultipleChoices {
+			return resp, nil
+		}
+		var status struct {
+			Status bool `json:"status"`
+			Data   struct {
+				Delivered bool `json:"delivered"`
+			} `json:"data"`
+		}
+		if err = resp.Decode(&status); err != nil {
+			return resp, err
+		}
+		if status.Data.Delivered || !status.Status {
+			return resp, nil
+		}
+	}
+	return resp, ErrTerminalEventNotDelivered
+}
+
 // TerminalStatus lets you check the availability of a Terminal before sending an event to it
 //
 // Example:
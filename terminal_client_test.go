@@ -0,0 +1,67 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPrintReceipt(t *testing.T) {
+	defer func(interval time.Duration, maxPolls int) {
+		terminalEventPollInterval, terminalEventMaxPolls = interval, maxPolls
+	}(terminalEventPollInterval, terminalEventMaxPolls)
+	terminalEventPollInterval, terminalEventMaxPolls = time.Millisecond, 3
+
+	newServer := func(status func(polls int32) (int, string)) (*httptest.Server, *int32) {
+		var polls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost {
+				w.Write([]byte(`{"status":true,"message":"Event sent to Terminal","data":{"id":"616d721e8c5cd40a0cdd54a6"}}`))
+				return
+			}
+			code, body := status(atomic.AddInt32(&polls, 1))
+			w.WriteHeader(code)
+			w.Write([]byte(body))
+		}))
+		t.Cleanup(server.Close)
+		return server, &polls
+	}
+
+	t.Run("delivered", func(t *testing.T) {
+		server, polls := newServer(func(polls int32) (int, string) {
+			return http.StatusOK, `{"status":true,"data":{"delivered":` + strconv.FormatBool(polls == 2) + `}}`
+		})
+		client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+		resp, err := client.Terminals.PrintReceipt(context.Background(), "30", "2953512344")
+		if err != nil || resp.StatusCode != http.StatusOK || atomic.LoadInt32(polls) != 2 {
+			t.Fatalf("got %v %v after %d polls", resp, err, atomic.LoadInt32(polls))
+		}
+	})
+
+	t.Run("unknown event stops polling", func(t *testing.T) {
+		server, polls := newServer(func(polls int32) (int, string) {
+			return http.StatusNotFound, `{"status":false,"message":"Event not found"}`
+		})
+		client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+		resp, err := client.Terminals.PrintReceipt(context.Background(), "30", "2953512344")
+		if err != nil || resp.StatusCode != http.StatusNotFound || atomic.LoadInt32(polls) != 1 {
+			t.Fatalf("got %v %v after %d polls", resp, err, atomic.LoadInt32(polls))
+		}
+	})
+
+	t.Run("undelivered event gives up", func(t *testing.T) {
+		server, polls := newServer(func(polls int32) (int, string) {
+			return http.StatusOK, `{"status":true,"data":{"delivered":false}}`
+		})
+		client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+		resp, err := client.Terminals.PrintReceipt(context.Background(), "30", "2953512344")
+		if !errors.Is(err, ErrTerminalEventNotDelivered) || resp == nil || atomic.LoadInt32(polls) != 3 {
+			t.Fatalf("got %v %v after %d polls", resp, err, atomic.LoadInt32(polls))
+		}
+	})
+}
@@ -0,0 +1,118 @@
+package paystack
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// TerminalInvoice contains the details of a payment request pushed to a Terminal with
+// TerminalClient.PushPaymentRequest that a POS can display to a customer. Customers paying by transfer
+// at the Terminal use OfflineReference as the narration of their transfer.
+type TerminalInvoice struct {
+	TerminalId       string
+	EventId          string
+	PaymentRequestId int64
+	RequestCode      string
+	OfflineReference string
+	Amount           int64
+	Currency         string
+	Description      string
+	DueDate          string
+	CustomerName     string
+	CustomerEmail    string
+}
+
+// DisplayAmount returns the amount of the TerminalInvoice in the major unit of its currency e.g.
+// `NGN 5,000.00` for an amount of 500000
+func (i TerminalInvoice) DisplayAmount() string {
+	major := fmt.Sprintf("%d", i.Amount/100)
+	var grouped strings.Builder
+	for index, digit := range major {
+		if index > 0 && (len(major)-index)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+	return fmt.Sprintf("%s %s.%02d", i.Currency, grouped.String(), i.Amount%100)
+}
+
+// PushPaymentRequest lets you push a payment request to a Terminal so that the customer can pay it at the
+// Terminal. The payment request is retrieved to send the `invoice` event with its `offline_reference`,
+// and the details a POS can display to the customer are returned as a TerminalInvoice.
+//
+// Example:
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	invoice, err := client.Terminals.PushPaymentRequest("30", "PRQ_kp4lleqc7g8xckk")
+//	if err != nil {
+//		panic(err)
+//	}
+//	fmt.Printf("Pay %s with the reference %s\n", invoice.DisplayAmount(), invoice.OfflineReference)
+func (t *TerminalClient) PushPaymentRequest(terminalId string, paymentRequestIdOrCode string) (TerminalInvoice, error) {
+	invoice := TerminalInvoice{TerminalId: terminalId}
+	resp, err := t.APICall(http.MethodGet, fmt.Sprintf("/paymentrequest/%s", paymentRequestIdOrCode), nil)
+	if err != nil {
+		return invoice, err
+	}
+	var paymentRequest struct {
+		Status  bool   `json:"status"`
+		Message string `json:"message"`
+		Data    struct {
+			Id               int64  `json:"id"`
+			RequestCode      string `json:"request_code"`
+			OfflineReference string `json:"offline_reference"`
+			Amount           int64  `json:"amount"`
+			Currency         string `json:"currency"`
+			Description      string `json:"description"`
+			DueDate          string `json:"due_date"`
+			Customer         struct {
+				FirstName string `json:"first_name"`
+				LastName  string `json:"last_name"`
+				Email     string `json:"email"`
+			} `json:"customer"`
+		} `json:"data"`
+	}
+	if err = resp.Decode(&paymentRequest); err != nil {
+		return invoice, err
+	}
+	if !paymentRequest.Status {
+		return invoice, fmt.Errorf("unable to fetch payment request %s: %s", paymentRequestIdOrCode, paymentRequest.Message)
+	}
+	data := paymentRequest.Data
+	invoice.PaymentRequestId = data.Id
+	invoice.RequestCode = data.RequestCode
+	invoice.OfflineReference = data.OfflineReference
+	invoice.Amount = data.Amount
+	invoice.Currency = data.Currency
+	invoice.Description = data.Description
+	invoice.DueDate = data.DueDate
+	invoice.CustomerName = strings.TrimSpace(data.Customer.FirstName + " " + data.Customer.LastName)
+	invoice.CustomerEmail = data.Customer.Email
+
+	resp, err = t.SendEvent(terminalId, TerminalEventInvoice, "process", map[string]interface{}{
+		"id":        data.Id,
+		"reference": data.OfflineReference,
+	})
+	if err != nil {
+		return invoice, err
+	}
+	var event struct {
+		Status  bool   `json:"status"`
+		Message string `json:"message"`
+		Data    struct {
+			Id string `json:"id"`
+		} `json:"data"`
+	}
+	if err = resp.Decode(&event); err != nil {
+		return invoice, err
+	}
+	if !event.Status {
+		return invoice, fmt.Errorf("unable to push payment request %s to terminal %s: %s",
+			paymentRequestIdOrCode, terminalId, event.Message)
+	}
+	invoice.EventId = event.Data.Id
+	return invoice, nil
+}
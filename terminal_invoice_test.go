@@ -0,0 +1,44 @@
+package paystack
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPushPaymentRequest(t *testing.T) {
+	var event map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/paymentrequest/PRQ_1":
+			w.Write([]byte(`{"status":true,"message":"Payment request retrieved","data":{"id":6304434,
+				"request_code":"PRQ_1","offline_reference":"4286263136","amount":123456789,"currency":"NGN",
+				"description":"Pedicure","due_date":"2024-07-08T00:00:00.000Z",
+				"customer":{"first_name":"John","last_name":"Doe","email":"johndoe@example.com"}}}`))
+		case "/terminal/30/event":
+			json.NewDecoder(r.Body).Decode(&event)
+			w.Write([]byte(`{"status":true,"message":"Event sent to Terminal","data":{"id":"616d721e8c5cd40a0cdd54a6"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	invoice, err := client.Terminals.PushPaymentRequest("30", "PRQ_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invoice.OfflineReference != "4286263136" || invoice.EventId != "616d721e8c5cd40a0cdd54a6" ||
+		invoice.CustomerName != "John Doe" {
+		t.Fatalf("unexpected invoice %+v", invoice)
+	}
+	if got := invoice.DisplayAmount(); got != "NGN 1,234,567.89" {
+		t.Fatalf("unexpected display amount %s", got)
+	}
+	data, _ := event["data"].(map[string]interface{})
+	if event["type"] != TerminalEventInvoice || data["reference"] != "4286263136" {
+		t.Fatalf("unexpected event %v", event)
+	}
+}
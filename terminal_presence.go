@@ -0,0 +1,200 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// TerminalPresence is the availability of a Terminal at the time it was checked
+type TerminalPresence struct {
+	TerminalId string
+	Online     bool
+	Available  bool
+	CheckedAt  time.Time
+}
+
+// TerminalPresenceTransition is emitted by a TerminalPresenceWatcher when the presence of a Terminal changes
+type TerminalPresenceTransition struct {
+	TerminalId string
+	Previous   TerminalPresence
+	Current    TerminalPresence
+}
+
+// TerminalPresenceWatcher polls the presence of a set of Terminals at an interval and emits the changes
+// in their presence. The last known presence of each Terminal is cached, so it can be used to display
+// the status of Terminals without checking the presence of a Terminal on every request. It should not be
+// instantiated directly but via the NewTerminalPresenceWatcher function.
+//
+// Example
+//
+//	import (
+//		"context"
+//		"time"
+//		p "github.com/gray-adeyi/paystack"
+//	)
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	watcher := p.NewTerminalPresenceWatcher(client.Terminals, 30*time.Second, "30", "31")
+//	for transition := range watcher.Watch(context.TODO()) {
+//		if transition.Previous.Online && !transition.Current.Online {
+//			fmt.Printf("terminal %s went offline\n", transition.TerminalId)
+//		}
+//	}
+type TerminalPresenceWatcher struct {
+	// OnError is called when the presence of a Terminal could not be checked. It should be set before
+	// calling Watch.
+	OnError func(terminalId string, err error)
+	// OfflineAfter is the number of consecutive checks of a Terminal that must fail before the Terminal is
+	// considered offline, since a Terminal that can not be reached is as good as offline to a dashboard.
+	// It defaults to DefaultTerminalOfflineAfter and should be set before calling Watch.
+	OfflineAfter int
+
+	client      *TerminalClient
+	interval    time.Duration
+	terminalIds []string
+	mu          sync.RWMutex
+	presences   map[string]TerminalPresence
+	failures    map[string]int
+}
+
+const (
+	// DefaultTerminalPresenceInterval is the interval of a TerminalPresenceWatcher created with an interval
+	// that is not positive
+	DefaultTerminalPresenceInterval = 30 * time.Second
+	// DefaultTerminalOfflineAfter is the default TerminalPresenceWatcher.OfflineAfter
+	DefaultTerminalOfflineAfter = 3
+)
+
+// NewTerminalPresenceWatcher creates a TerminalPresenceWatcher that checks the presence of the Terminals
+// with terminalIds every interval. DefaultTerminalPresenceInterval is used if interval is not positive.
+func NewTerminalPresenceWatcher(client *TerminalClient, interval time.Duration, terminalIds ...string) *TerminalPresenceWatcher {
+	if interval <= 0 {
+		interval = DefaultTerminalPresenceInterval
+	}
+	return &TerminalPresenceWatcher{
+		OfflineAfter: DefaultTerminalOfflineAfter,
+		client:       client,
+		interval:     interval,
+		terminalIds:  terminalIds,
+		presences:    make(map[string]TerminalPresence),
+		failures:     make(map[string]int),
+	}
+}
+
+// Presence returns the last known presence of a Terminal. false is returned if the presence of the
+// Terminal has not been checked yet.
+func (w *TerminalPresenceWatcher) Presence(terminalId string) (TerminalPresence, bool) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	presence, ok := w.presences[terminalId]
+	return presence, ok
+}
+
+// Watch starts polling the presence of the Terminals until ctx is done. The returned channel receives a
+// TerminalPresenceTransition whenever the presence of a Terminal changes, and it is closed when ctx is
+// done. The first check of a Terminal is cached but not emitted as a transition. A Terminal whose checks
+// fail OfflineAfter times in a row is cached, and emitted, as offline and unavailable until it is checked
+// successfully again.
+func (w *TerminalPresenceWatcher) Watch(ctx context.Context) <-chan TerminalPresenceTransition {
+	transitions := make(chan TerminalPresenceTransition)
+	go func() {
+		defer close(transitions)
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			for _, terminalId := range w.terminalIds {
+				transition, changed := w.check(ctx, terminalId)
+				if !changed {
+					continue
+				}
+				select {
+				case transitions <- transition:
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return transitions
+}
+
+// check checks the presence of a Terminal, caching it, and returns the transition of the Terminal if its
+// presence changed
+func (w *TerminalPresenceWatcher) check(ctx context.Context, terminalId string) (TerminalPresenceTransition, bool) {
+	resp, err := w.client.apiCall(ctx, http.MethodGet, fmt.Sprintf("/terminal/%s/presence", terminalId), nil)
+	var presence struct {
+		Data struct {
+			Online    bool `json:"online"`
+			Available bool `json:"available"`
+		} `json:"data"`
+	}
+	if err == nil {
+		err = resp.AsError()
+	}
+	if err == nil {
+		err = resp.Decode(&presence)
+	}
+	if err != nil {
+		err = fmt.Errorf("unable to check the presence of terminal %s: %w", terminalId, err)
+		if ctx.Err() != nil {
+			// the watcher is stopping, the Terminal is not at fault
+			return TerminalPresenceTransition{}, false
+		}
+		if w.OnError != nil {
+			// a panicking OnError must not stop the watcher
+			w.client.callbackSafely(ctx, "terminal presence error handler", func() {
+				w.OnError(terminalId, err)
+			})
+		}
+		return w.failed(terminalId)
+	}
+
+	return w.update(TerminalPresence{
+		TerminalId: terminalId,
+		Online:     presence.Data.Online,
+		Available:  presence.Data.Available,
+		CheckedAt:  time.Now(),
+	}, true)
+}
+
+// failed records a failed check of a Terminal, updating its presence to offline once OfflineAfter checks
+// have failed in a row
+func (w *TerminalPresenceWatcher) failed(terminalId string) (TerminalPresenceTransition, bool) {
+	offlineAfter := w.OfflineAfter
+	if offlineAfter <= 0 {
+		offlineAfter = DefaultTerminalOfflineAfter
+	}
+	w.mu.Lock()
+	w.failures[terminalId]++
+	failures := w.failures[terminalId]
+	w.mu.Unlock()
+	if failures < offlineAfter {
+		return TerminalPresenceTransition{}, false
+	}
+	return w.update(TerminalPresence{TerminalId: terminalId, CheckedAt: time.Now()}, false)
+}
+
+// update caches the presence of a Terminal and returns its transition if it differs from the last known
+// presence of the Terminal. checked reports whether current is the result of a successful check.
+func (w *TerminalPresenceWatcher) update(current TerminalPresence, checked bool) (TerminalPresenceTransition, bool) {
+	w.mu.Lock()
+	previous, known := w.presences[current.TerminalId]
+	w.presences[current.TerminalId] = current
+	if checked {
+		delete(w.failures, current.TerminalId)
+	}
+	w.mu.Unlock()
+
+	if !known || (previous.Online == current.Online && previous.Available == current.Available) {
+		return TerminalPresenceTransition{}, false
+	}
+	return TerminalPresenceTransition{TerminalId: current.TerminalId, Previous: previous, Current: current}, true
+}
@@ -0,0 +1,154 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// terminalPresenceServer is a fake of the terminal presence endpoint that responds with the presence, or
+// a failure when it is empty, set for a Terminal
+type terminalPresenceServer struct {
+	mu        sync.Mutex
+	presences map[string]string
+}
+
+func (f *terminalPresenceServer) set(terminalId string, presence string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.presences[terminalId] = presence
+}
+
+func (f *terminalPresenceServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	presence := f.presences[r.URL.Path]
+	if presence == "" {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"status":false,"message":"Something went wrong"}`))
+		return
+	}
+	w.Write([]byte(`{"status":true,"data":` + presence + `}`))
+}
+
+const (
+	terminalOnline  = `{"online":true,"available":true}`
+	terminalBusy    = `{"online":true,"available":false}`
+	terminalOffline = `{"online":false,"available":false}`
+)
+
+func newTerminalPresenceWatcher(t *testing.T, interval time.Duration) (*TerminalPresenceWatcher, *terminalPresenceServer) {
+	fake := &terminalPresenceServer{presences: map[string]string{"/terminal/30/presence": terminalOnline}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	client := NewTerminalClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+	return NewTerminalPresenceWatcher(client, interval, "30"), fake
+}
+
+func TestTerminalPresenceWatcherCheck(t *testing.T) {
+	watcher, fake := newTerminalPresenceWatcher(t, time.Minute)
+	ctx := context.Background()
+
+	if _, ok := watcher.Presence("30"); ok {
+		t.Fatal("expected an unchecked terminal to have no presence")
+	}
+	if _, changed := watcher.check(ctx, "30"); changed {
+		t.Fatal("expected the first check not to be a transition")
+	}
+	if presence, ok := watcher.Presence("30"); !ok || !presence.Online || !presence.Available || presence.CheckedAt.IsZero() {
+		t.Fatalf("expected the first check to be cached, got %+v", presence)
+	}
+	if _, changed := watcher.check(ctx, "30"); changed {
+		t.Fatal("expected an unchanged presence not to be a transition")
+	}
+
+	fake.set("/terminal/30/presence", terminalBusy)
+	transition, changed := watcher.check(ctx, "30")
+	if !changed || !transition.Previous.Available || transition.Current.Available || !transition.Current.Online {
+		t.Fatalf("expected a transition to busy, got %+v", transition)
+	}
+	if presence, _ := watcher.Presence("30"); presence.Available {
+		t.Fatalf("expected the transition to be cached, got %+v", presence)
+	}
+}
+
+func TestTerminalPresenceWatcherFailedChecks(t *testing.T) {
+	watcher, fake := newTerminalPresenceWatcher(t, time.Minute)
+	watcher.OfflineAfter = 2
+	var errs []error
+	watcher.OnError = func(terminalId string, err error) {
+		errs = append(errs, err)
+	}
+	ctx := context.Background()
+	watcher.check(ctx, "30")
+
+	fake.set("/terminal/30/presence", "")
+	if _, changed := watcher.check(ctx, "30"); changed {
+		t.Fatal("expected a single failed check not to be a transition")
+	}
+	if presence, _ := watcher.Presence("30"); !presence.Online {
+		t.Fatalf("expected a single failed check to keep the last known presence, got %+v", presence)
+	}
+	transition, changed := watcher.check(ctx, "30")
+	if !changed || !transition.Previous.Online || transition.Current.Online || transition.Current.Available {
+		t.Fatalf("expected failed checks to take the terminal offline, got %+v", transition)
+	}
+	if _, changed = watcher.check(ctx, "30"); changed {
+		t.Fatal("expected a terminal that is already offline not to transition again")
+	}
+	if len(errs) != 3 || !errors.Is(errs[0], ErrServer) {
+		t.Fatalf("expected every failed check to be reported, got %v", errs)
+	}
+
+	fake.set("/terminal/30/presence", terminalOnline)
+	if transition, changed = watcher.check(ctx, "30"); !changed || !transition.Current.Online {
+		t.Fatalf("expected a successful check to bring the terminal back online, got %+v", transition)
+	}
+	fake.set("/terminal/30/presence", "")
+	if _, changed = watcher.check(ctx, "30"); changed {
+		t.Fatal("expected a successful check to reset the failed checks")
+	}
+}
+
+func TestTerminalPresenceWatcherWatch(t *testing.T) {
+	watcher, fake := newTerminalPresenceWatcher(t, time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	transitions := watcher.Watch(ctx)
+	for {
+		if _, ok := watcher.Presence("30"); ok {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	fake.set("/terminal/30/presence", terminalOffline)
+	select {
+	case transition := <-transitions:
+		if transition.TerminalId != "30" || !transition.Previous.Online || transition.Current.Online {
+			t.Fatalf("expected the terminal to go offline, got %+v", transition)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a transition")
+	}
+
+	cancel()
+	for range transitions {
+	}
+}
+
+func TestTerminalPresenceWatcherDefaultInterval(t *testing.T) {
+	watcher, _ := newTerminalPresenceWatcher(t, 0)
+	if watcher.interval != DefaultTerminalPresenceInterval {
+		t.Fatalf("expected %v, got %v", DefaultTerminalPresenceInterval, watcher.interval)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	transitions := watcher.Watch(ctx)
+	cancel()
+	for range transitions {
+	}
+}
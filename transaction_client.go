@@ -1,6 +1,7 @@
 package paystack
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -62,6 +63,20 @@ func NewTransactionClient(options ...ClientOptions) *TransactionClient {
 //	}
 //	fmt.Println(data)
 func (t *TransactionClient) Initialize(amount int, email string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error) {
+	return t.InitializeContext(context.Background(), amount, email, optionalPayloadParameters...)
+}
+
+// InitializeContext lets you initialize a transaction from your backend like Initialize, with ctx. The
+// call is canceled when ctx is done and the CallOption of ctx e.g. WithRequestTimeout are applied to it.
+//
+// Example:
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	ctx := p.WithCallOptions(r.Context(), p.WithRequestTimeout(5*time.Second))
+//	resp, err := client.Transactions.InitializeContext(ctx, 200000, "johndoe@example.com")
+func (t *TransactionClient) InitializeContext(ctx context.Context, amount int, email string, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error) {
 	payload := make(map[string]interface{})
 	payload["amount"] = amount
 	payload["email"] = email
@@ -69,7 +84,7 @@ func (t *TransactionClient) Initialize(amount int, email string, optionalPayload
 	for _, optionalPayloadParameter := range optionalPayloadParameters {
 		payload = optionalPayloadParameter(payload)
 	}
-	return t.APICall(http.MethodPost, "/transaction/initialize", payload)
+	return t.apiCall(ctx, http.MethodPost, "/transaction/initialize", payload)
 }
 
 // Verify lets you confirm the status of a transaction
@@ -103,7 +118,21 @@ func (t *TransactionClient) Initialize(amount int, email string, optionalPayload
 //	}
 //	fmt.Println(data)
 func (t *TransactionClient) Verify(reference string) (*Response, error) {
-	return t.APICall(http.MethodGet, fmt.Sprintf("/transaction/verify/%s", reference), nil)
+	return t.verify(context.Background(), reference, true)
+}
+
+// VerifyContext lets you confirm the status of a transaction like Verify, with ctx. The call is canceled
+// when ctx is done and the CallOption of ctx e.g. WithRequestTimeout are applied to it.
+//
+// Example:
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	ctx := p.WithCallOptions(r.Context(), p.WithRequestTimeout(5*time.Second))
+//	resp, err := client.Transactions.VerifyContext(ctx, "<reference>")
+func (t *TransactionClient) VerifyContext(ctx context.Context, reference string) (*Response, error) {
+	return t.verify(ctx, reference, true)
 }
 
 // All lets you list Transactions carried out on your Integration
@@ -181,6 +210,34 @@ func (t *TransactionClient) FetchOne(id string) (*Response, error) {
 	return t.APICall(http.MethodGet, fmt.Sprintf("/transaction/%s", id), nil)
 }
 
+// FetchByReference lets you get the details of a transaction carried out on your Integration using its
+// reference instead of the numeric id paystack assigns it, so you don't need to store paystack's ids.
+// The `data` of the response is the same transaction FetchOne returns.
+//
+// Example:
+//
+//	import (
+//		"context"
+//		p "github.com/gray-adeyi/paystack"
+//	)
+//
+//	txnClient := p.NewTransactionClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// Alternatively, you can access a transaction client from an APIClient
+//	// paystackClient := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// paystackClient.Transactions field is a `TransactionClient`
+//	// Therefore, this is possible
+//	// resp, err := paystackClient.Transactions.FetchByReference(context.TODO(), "<reference>")
+//
+//	resp, err := txnClient.FetchByReference(context.TODO(), "<reference>")
+//	if err != nil {
+//		panic(err)
+//	}
+//	fmt.Println(string(resp.Data))
+func (t *TransactionClient) FetchByReference(ctx context.Context, reference string) (*Response, error) {
+	// the transaction in the response of verifying a transaction is the same as the one of fetching it
+	return t.verify(ctx, reference, true)
+}
+
 // ChargeAuthorization lets you charge authorizations that are marked as reusable
 //
 // Example:
@@ -395,3 +452,74 @@ func (t *TransactionClient) PartialDebit(authorizationCode string, currency stri
 	}
 	return t.APICall(http.MethodPost, "/transaction/partial_debit", payload)
 }
+
+// VerifyMany lets you confirm the status of multiple transactions at the same time. The responses are
+// returned in the order of references. How many transactions are verified at the same time and how
+// errors are handled is configured with options. No new verification is started once ctx is done, in which
+// case the responses of the completed verifications are returned alongside a *DeadlineExceededError listing
+// the references that are left to verify.
+//
+// Example:
+//
+//	import (
+//		"context"
+//		"fmt"
+//		p "github.com/gray-adeyi/paystack"
+//	)
+//
+//	txnClient := p.NewTransactionClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// Alternatively, you can access a transaction client from an APIClient
+//	// paystackClient := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// paystackClient.Transactions field is a `TransactionClient`
+//	// Therefore, this is possible
+//	// responses, err := paystackClient.Transactions.VerifyMany(context.TODO(), references, p.ParallelOptions{})
+//
+//	references := []string{"<reference-1>", "<reference-2>"}
+//	responses, err := txnClient.VerifyMany(context.TODO(), references,
+//		p.ParallelOptions{Workers: 8, Mode: p.ParallelCollectAll})
+//	if err != nil {
+//		panic(err)
+//	}
+//	for i, resp := range responses {
+//		fmt.Println(references[i], resp.StatusCode)
+//	}
+func (t *TransactionClient) VerifyMany(ctx context.Context, references []string, options ParallelOptions) ([]*Response, error) {
+	responses := make([]*Response, len(references))
+	_, err := parallel(ctx, len(references), options, func(ctx context.Context, i int) error {
+		resp, err := t.verify(ctx, references[i], true)
+		if err != nil {
+			return fmt.Errorf("unable to verify transaction %s: %w", references[i], err)
+		}
+		responses[i] = resp
+		return nil
+	})
+	return responses, err
+}
+
+// InitializeWithSplit lets you initialize a transaction whose payment is split using a single use
+// DynamicSplit instead of the split code of a split created with TransactionSplitClient.Create. The
+// split is validated before the transaction is initialized and an error wrapping ErrInvalidDynamicSplit
+// is returned if it is not valid.
+//
+// Example:
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	txnClient := p.NewTransactionClient(p.WithSecretKey("<paystack-secret-key>"))
+//	split, err := p.NewSplitBuilder(p.SplitTypePercentage).
+//		Add("ACCT_z3x6z3nbo14xsil", 20).
+//		Add("ACCT_pwwualwty4nhq9d", 30).
+//		Build()
+//	if err != nil {
+//		panic(err)
+//	}
+//	resp, err := txnClient.InitializeWithSplit(200000, "johndoe@example.com", split)
+//	if err != nil {
+//		panic(err)
+//	}
+func (t *TransactionClient) InitializeWithSplit(amount int, email string, split DynamicSplit, optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error) {
+	if err := split.Validate(); err != nil {
+		return nil, err
+	}
+	return t.Initialize(amount, email, append(optionalPayloadParameters, WithDynamicSplit(split))...)
+}
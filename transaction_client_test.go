@@ -1,8 +1,12 @@
 package paystack
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -35,3 +39,35 @@ func TestCanInitializeWithOptionalParameters(t *testing.T) {
 	}
 	fmt.Println(g)
 }
+
+func TestFetchByReference(t *testing.T) {
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Write([]byte(`{"status":true,"message":"Verification successful","data":{"id":4099260516,"reference":"ref"}}`))
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	resp, err := client.Transactions.FetchByReference(context.Background(), "ref")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/transaction/verify/ref" {
+		t.Fatalf("unexpected path %s", path)
+	}
+	var transaction struct {
+		Data struct {
+			Id int64 `json:"id"`
+		} `json:"data"`
+	}
+	if err = resp.Decode(&transaction); err != nil || transaction.Data.Id != 4099260516 {
+		t.Fatalf("unexpected transaction %+v: %v", transaction, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = client.Transactions.FetchByReference(ctx, "ref"); !errors.Is(err, ErrRequestCanceled) {
+		t.Fatalf("expected a canceled context error, got %v", err)
+	}
+}
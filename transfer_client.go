@@ -1,6 +1,7 @@
 package paystack
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -64,6 +65,21 @@ func NewTransferClient(options ...ClientOptions) *TransferClient {
 //	}
 //	fmt.Println(data)
 func (t *TransferClient) Initiate(source string, amount int, recipient string,
+	optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error) {
+	return t.InitiateContext(context.Background(), source, amount, recipient, optionalPayloadParameters...)
+}
+
+// InitiateContext lets you send money to your customers like Initiate, with ctx. The call is canceled when
+// ctx is done and the CallOption of ctx e.g. WithIdempotencyKey are applied to it.
+//
+// Example:
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	ctx = p.WithCallOptions(ctx, p.WithIdempotencyKey("payout-"+payout.Id))
+//	resp, err := client.Transfers.InitiateContext(ctx, "balance", 500000, "RCP_gx2wn530m0i3w3m")
+func (t *TransferClient) InitiateContext(ctx context.Context, source string, amount int, recipient string,
 	optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error) {
 	payload := make(map[string]interface{})
 	payload["source"] = source
@@ -73,7 +89,7 @@ func (t *TransferClient) Initiate(source string, amount int, recipient string,
 	for _, optionalPayloadParameter := range optionalPayloadParameters {
 		payload = optionalPayloadParameter(payload)
 	}
-	return t.APICall(http.MethodPost, "/transfer", payload)
+	return t.apiCall(ctx, http.MethodPost, "/transfer", payload)
 }
 
 // Finalize lets you finalize an initiated transfer
@@ -0,0 +1,124 @@
+package paystack
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrUnsupportedTransferFeeCurrency is returned by TransferClient.FeeFor for a currency whose transfer
+// fee schedule is not known to the SDK
+var ErrUnsupportedTransferFeeCurrency = errors.New("transfer fee schedule is not available for currency")
+
+// transferFeeTier is a tier of a transfer fee schedule. Transfers of an amount up to and including
+// upTo are charged fee. A tier whose upTo is 0 has no upper bound.
+type transferFeeTier struct {
+	upTo int
+	fee  int
+}
+
+// transferFeeSchedules are the transfer fees published by paystack per currency. Amounts and fees are
+// in the subunit of the currency.
+var transferFeeSchedules = map[string][]transferFeeTier{
+	"NGN": {
+		{upTo: 500000, fee: 1000},
+		{upTo: 5000000, fee: 2500},
+		{upTo: 0, fee: 5000},
+	},
+}
+
+// TransferFeePreview is the fee of a transfer and whether your balance can cover it. It is returned by
+// TransferClient.PreviewFee.
+type TransferFeePreview struct {
+	Currency string
+	Amount   int
+	Fee      int
+	// Total is the amount of the transfer and its fee
+	Total int
+	// Balance is the available balance of your Integration in the currency of the transfer
+	Balance int
+	// Affordable reports whether Balance covers Total
+	Affordable bool
+	// Warning describes why the transfer is not affordable. It is empty when the transfer is affordable.
+	Warning string
+}
+
+// FeeFor lets you compute the fee paystack charges for a transfer of amount in currency using the transfer
+// fee schedule published by paystack. amount and the fee are in the subunit of the currency e.g. kobo.
+// ErrUnsupportedTransferFeeCurrency is returned for currencies whose fee schedule is not known.
+//
+// Example:
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	tfClient := p.NewTransferClient(p.WithSecretKey("<paystack-secret-key>"))
+//	fee, err := tfClient.FeeFor(1000000, "NGN")
+//	if err != nil {
+//		panic(err)
+//	}
+//	fmt.Println(fee) // 2500
+func (t *TransferClient) FeeFor(amount int, currency string) (int, error) {
+	schedule, ok := transferFeeSchedules[currency]
+	if !ok {
+		return 0, fmt.Errorf("%w %s", ErrUnsupportedTransferFeeCurrency, currency)
+	}
+	for _, tier := range schedule {
+		if tier.upTo == 0 || amount <= tier.upTo {
+			return tier.fee, nil
+		}
+	}
+	return schedule[len(schedule)-1].fee, nil
+}
+
+// PreviewFee lets you compute the fee of a transfer with TransferClient.FeeFor and check that the available
+// balance of your Integration covers the transfer and its fee before initiating it.
+//
+// Example:
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	tfClient := p.NewTransferClient(p.WithSecretKey("<paystack-secret-key>"))
+//	preview, err := tfClient.PreviewFee(1000000, "NGN")
+//	if err != nil {
+//		panic(err)
+//	}
+//	if !preview.Affordable {
+//		log.Println(preview.Warning)
+//	}
+func (t *TransferClient) PreviewFee(amount int, currency string) (TransferFeePreview, error) {
+	fee, err := t.FeeFor(amount, currency)
+	if err != nil {
+		return TransferFeePreview{}, err
+	}
+	preview := TransferFeePreview{Currency: currency, Amount: amount, Fee: fee, Total: amount + fee}
+
+	resp, err := t.APICall(http.MethodGet, "/balance", nil)
+	if err != nil {
+		return preview, err
+	}
+	var balances struct {
+		Status  bool   `json:"status"`
+		Message string `json:"message"`
+		Data    []struct {
+			Currency string `json:"currency"`
+			Balance  int    `json:"balance"`
+		} `json:"data"`
+	}
+	if err = resp.Decode(&balances); err != nil {
+		return preview, err
+	}
+	if !balances.Status {
+		return preview, fmt.Errorf("unable to retrieve balance: %s", balances.Message)
+	}
+	for _, balance := range balances.Data {
+		if balance.Currency == currency {
+			preview.Balance = balance.Balance
+		}
+	}
+	preview.Affordable = preview.Balance >= preview.Total
+	if !preview.Affordable {
+		preview.Warning = fmt.Sprintf("available balance of %d %s does not cover the transfer of %d and its fee of %d",
+			preview.Balance, currency, amount, fee)
+	}
+	return preview, nil
+}
@@ -0,0 +1,29 @@
+package paystack
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTransferFeeFor(t *testing.T) {
+	client := NewTransferClient(WithSecretKey("sk_test"))
+	cases := []struct {
+		amount int
+		fee    int
+	}{
+		{amount: 100, fee: 1000},
+		{amount: 500000, fee: 1000},
+		{amount: 500001, fee: 2500},
+		{amount: 5000000, fee: 2500},
+		{amount: 5000001, fee: 5000},
+	}
+	for _, c := range cases {
+		fee, err := client.FeeFor(c.amount, "NGN")
+		if err != nil || fee != c.fee {
+			t.Errorf("FeeFor(%d) = %d, %v, expected %d", c.amount, fee, err, c.fee)
+		}
+	}
+	if _, err := client.FeeFor(100, "USD"); !errors.Is(err, ErrUnsupportedTransferFeeCurrency) {
+		t.Errorf("expected %v, got %v", ErrUnsupportedTransferFeeCurrency, err)
+	}
+}
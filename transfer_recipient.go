@@ -3,8 +3,19 @@ package paystack
 import (
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 )
 
+// RecipientType specifies the transfer recipient types supported by paystack
+type RecipientType = string
+
+const RecipientTypeNuban RecipientType = "nuban"
+const RecipientTypeGhipss RecipientType = "ghipss"
+const RecipientTypeMobileMoney RecipientType = "mobile_money"
+const RecipientTypeBasa RecipientType = "basa"
+const RecipientTypeAuthorization RecipientType = "authorization"
+
 // TransferRecipientClient interacts with endpoints related to paystack transfer recipient resource
 // that lets you create and manage beneficiaries that you send money to.
 type TransferRecipientClient struct {
@@ -75,6 +86,161 @@ func (t *TransferRecipientClient) Create(recipientType string, name string, acco
 	return t.APICall(http.MethodPost, "/transferrecipient", payload)
 }
 
+// CreateMobileMoney lets you create a mobile money transfer recipient. Mobile money recipients are supported
+// in Ghana (GHS) and Kenya (KES). provider is the MobileMoneyProvider of the recipient e.g. MobileMoneyMTN
+// and it is validated against currency with ValidateMobileMoneyProvider. The codes of the providers can
+// also be retrieved from `Miscellaneous.Banks` by filtering with the currency and the `type` query set
+// to `mobile_money`.
+//
+// Example:
+//
+//	import (
+//		"fmt"
+//		p "github.com/gray-adeyi/paystack"
+//		"encoding/json"
+//	)
+//
+//	trClient := p.NewTransferRecipientClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// Alternatively, you can access the transfer recipient client from an APIClient
+//	// paystackClient := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// paystackClient.TransferRecipients field is a `TransferRecipientClient`
+//	// Therefore, this is possible
+//	// resp, err := paystackClient.TransferRecipients.CreateMobileMoney("GHS","Kofi Mensah","MTN","0551234987")
+//
+//	resp, err := trClient.CreateMobileMoney("GHS","Kofi Mensah","MTN","0551234987")
+//	if err != nil {
+//		panic(err)
+//	}
+//	// you can have data be a custom structure based on the data your interested in retrieving from
+//	// from paystack for simplicity, we're using `map[string]interface{}` which is sufficient to
+//	// to serialize the json data returned by paystack
+//	data := make(map[string]interface{})
+//
+//	err := json.Unmarshal(resp.Data, &data); if err != nil {
+//		panic(err)
+//	}
+//	fmt.Println(data)
+func (t *TransferRecipientClient) CreateMobileMoney(currency string, name string, provider string, phone string,
+	optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error) {
+	if currency != "GHS" && currency != "KES" {
+		return nil, fmt.Errorf("mobile money recipients are only supported for GHS and KES, got %q", currency)
+	}
+	if err := requireRecipientFields(map[string]string{"name": name, "provider": provider, "phone": phone}); err != nil {
+		return nil, err
+	}
+	if err := ValidateMobileMoneyProvider(currency, provider); err != nil {
+		return nil, err
+	}
+	optionalPayloadParameters = append(optionalPayloadParameters, WithOptionalParameter("currency", currency))
+	return t.Create(RecipientTypeMobileMoney, name, phone, strings.ToUpper(provider), optionalPayloadParameters...)
+}
+
+// CreateBasa lets you create a transfer recipient for a South African (ZAR) bank account.
+//
+// Example:
+//
+//	import (
+//		"fmt"
+//		p "github.com/gray-adeyi/paystack"
+//		"encoding/json"
+//	)
+//
+//	trClient := p.NewTransferRecipientClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// Alternatively, you can access the transfer recipient client from an APIClient
+//	// paystackClient := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// paystackClient.TransferRecipients field is a `TransferRecipientClient`
+//	// Therefore, this is possible
+//	// resp, err := paystackClient.TransferRecipients.CreateBasa("Thandi Nkosi","0123456789","632005")
+//
+//	resp, err := trClient.CreateBasa("Thandi Nkosi","0123456789","632005")
+//	if err != nil {
+//		panic(err)
+//	}
+//	// you can have data be a custom structure based on the data your interested in retrieving from
+//	// from paystack for simplicity, we're using `map[string]interface{}` which is sufficient to
+//	// to serialize the json data returned by paystack
+//	data := make(map[string]interface{})
+//
+//	err := json.Unmarshal(resp.Data, &data); if err != nil {
+//		panic(err)
+//	}
+//	fmt.Println(data)
+func (t *TransferRecipientClient) CreateBasa(name string, accountNumber string, bankCode string,
+	optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error) {
+	if err := requireRecipientFields(map[string]string{
+		"name": name, "account number": accountNumber, "bank code": bankCode}); err != nil {
+		return nil, err
+	}
+	optionalPayloadParameters = append(optionalPayloadParameters, WithOptionalParameter("currency", "ZAR"))
+	return t.Create(RecipientTypeBasa, name, accountNumber, bankCode, optionalPayloadParameters...)
+}
+
+// CreateAuthorization lets you create a transfer recipient from the authorization of a card that was
+// previously charged on your Integration. It is used for payouts to cards.
+//
+// Example:
+//
+//	import (
+//		"fmt"
+//		p "github.com/gray-adeyi/paystack"
+//		"encoding/json"
+//	)
+//
+//	trClient := p.NewTransferRecipientClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// Alternatively, you can access the transfer recipient client from an APIClient
+//	// paystackClient := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// paystackClient.TransferRecipients field is a `TransferRecipientClient`
+//	// Therefore, this is possible
+//	// resp, err := paystackClient.TransferRecipients.CreateAuthorization("Tolu Robert","johndoe@example.com","AUTH_xxx")
+//
+//	resp, err := trClient.CreateAuthorization("Tolu Robert","johndoe@example.com","AUTH_xxx")
+//	if err != nil {
+//		panic(err)
+//	}
+//	// you can have data be a custom structure based on the data your interested in retrieving from
+//	// from paystack for simplicity, we're using `map[string]interface{}` which is sufficient to
+//	// to serialize the json data returned by paystack
+//	data := make(map[string]interface{})
+//
+//	err := json.Unmarshal(resp.Data, &data); if err != nil {
+//		panic(err)
+//	}
+//	fmt.Println(data)
+func (t *TransferRecipientClient) CreateAuthorization(name string, email string, authorizationCode string,
+	optionalPayloadParameters ...OptionalPayloadParameter) (*Response, error) {
+	if err := requireRecipientFields(map[string]string{
+		"name": name, "email": email, "authorization code": authorizationCode}); err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(authorizationCode, "AUTH_") {
+		return nil, fmt.Errorf("invalid authorization code %q", authorizationCode)
+	}
+	payload := map[string]interface{}{
+		"type":               RecipientTypeAuthorization,
+		"name":               name,
+		"email":              email,
+		"authorization_code": authorizationCode,
+	}
+	for _, optionalPayloadParameter := range optionalPayloadParameters {
+		payload = optionalPayloadParameter(payload)
+	}
+	return t.APICall(http.MethodPost, "/transferrecipient", payload)
+}
+
+func requireRecipientFields(fields map[string]string) error {
+	var missing []string
+	for name, value := range fields {
+		if strings.TrimSpace(value) == "" {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("missing required transfer recipient fields: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
 // BulkCreate lets you create multiple transfer recipients in batches. A duplicate account number will lead to the retrieval of the existing record.
 //
 // Example:
@@ -0,0 +1,152 @@
+package paystack
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// newTransferRecipientServer returns a server that records the payloads of the recipients it creates
+func newTransferRecipientServer(t *testing.T, payloads *[]map[string]interface{}) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/transferrecipient" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		var payload map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		*payloads = append(*payloads, payload)
+		w.Write([]byte(`{"status":true,"message":"Transfer recipient created successfully"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCreateTypedTransferRecipients(t *testing.T) {
+	var payloads []map[string]interface{}
+	server := newTransferRecipientServer(t, &payloads)
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	tests := []struct {
+		name   string
+		create func() (*Response, error)
+		want   map[string]interface{}
+	}{
+		{
+			name: "mobile money",
+			create: func() (*Response, error) {
+				return client.TransferRecipients.CreateMobileMoney("GHS", "Kwame Mensah", "mtn", "0551234987",
+					WithOptionalParameter("description", "Supplier"))
+			},
+			want: map[string]interface{}{
+				"type":           RecipientTypeMobileMoney,
+				"name":           "Kwame Mensah",
+				"account_number": "0551234987",
+				"bank_code":      "MTN",
+				"currency":       "GHS",
+				"description":    "Supplier",
+			},
+		},
+		{
+			name: "basa",
+			create: func() (*Response, error) {
+				return client.TransferRecipients.CreateBasa("Thabo Nkosi", "0123456789", "198765")
+			},
+			want: map[string]interface{}{
+				"type":           RecipientTypeBasa,
+				"name":           "Thabo Nkosi",
+				"account_number": "0123456789",
+				"bank_code":      "198765",
+				"currency":       "ZAR",
+			},
+		},
+		{
+			name: "authorization",
+			create: func() (*Response, error) {
+				return client.TransferRecipients.CreateAuthorization("Tolu Robert", "tolu@example.com", "AUTH_ncx8hews93")
+			},
+			want: map[string]interface{}{
+				"type":               RecipientTypeAuthorization,
+				"name":               "Tolu Robert",
+				"email":              "tolu@example.com",
+				"authorization_code": "AUTH_ncx8hews93",
+			},
+		},
+	}
+	for _, tt := range tests {
+		payloads = nil
+		if _, err := tt.create(); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if len(payloads) != 1 || !reflect.DeepEqual(payloads[0], tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, payloads)
+		}
+	}
+}
+
+func TestCreateTypedTransferRecipientsValidation(t *testing.T) {
+	var payloads []map[string]interface{}
+	server := newTransferRecipientServer(t, &payloads)
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL))
+
+	tests := []struct {
+		name    string
+		create  func() (*Response, error)
+		wantErr string
+		is      error
+	}{
+		{
+			name: "mobile money in an unsupported currency",
+			create: func() (*Response, error) {
+				return client.TransferRecipients.CreateMobileMoney("NGN", "Tolu Robert", "mtn", "08012345678")
+			},
+			wantErr: `mobile money recipients are only supported for GHS and KES, got "NGN"`,
+		},
+		{
+			name: "mobile money provider of another currency",
+			create: func() (*Response, error) {
+				return client.TransferRecipients.CreateMobileMoney("KES", "Amina Otieno", "mtn", "0712345678")
+			},
+			is: ErrInvalidMobileMoneyProvider,
+		},
+		{
+			name: "mobile money without a phone",
+			create: func() (*Response, error) {
+				return client.TransferRecipients.CreateMobileMoney("KES", "Amina Otieno", "mpesa", " ")
+			},
+			wantErr: "missing required transfer recipient fields: phone",
+		},
+		{
+			name: "basa without an account",
+			create: func() (*Response, error) {
+				return client.TransferRecipients.CreateBasa("Thabo Nkosi", "", "")
+			},
+			wantErr: "missing required transfer recipient fields: account number, bank code",
+		},
+		{
+			name: "authorization without a name",
+			create: func() (*Response, error) {
+				return client.TransferRecipients.CreateAuthorization("", "tolu@example.com", "AUTH_ncx8hews93")
+			},
+			wantErr: "missing required transfer recipient fields: name",
+		},
+		{
+			name: "invalid authorization code",
+			create: func() (*Response, error) {
+				return client.TransferRecipients.CreateAuthorization("Tolu Robert", "tolu@example.com", "ncx8hews93")
+			},
+			wantErr: `invalid authorization code "ncx8hews93"`,
+		},
+	}
+	for _, tt := range tests {
+		_, err := tt.create()
+		if err == nil || (tt.wantErr != "" && err.Error() != tt.wantErr) || (tt.is != nil && !errors.Is(err, tt.is)) {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		}
+	}
+	if len(payloads) != 0 {
+		t.Fatalf("expected invalid recipients not to be sent, got %v", payloads)
+	}
+}
@@ -0,0 +1,96 @@
+package paystack
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// WithTunedTransport lets you create an APIClient whose transport is tuned for latency sensitive
+// deployments, e.g. serverless functions that cold start frequently. Resolved addresses of hosts are
+// cached for dnsCacheTTL, TLS sessions are resumed with session tickets and idle connections are kept
+// around longer, cutting the latency of setting up connections to paystack.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"), p.WithTunedTransport(5*time.Minute))
+func WithTunedTransport(dnsCacheTTL time.Duration) ClientOptions {
+	return func(client *APIClient) {
+		client.dnsCacheTTL = dnsCacheTTL
+	}
+}
+
+// newTunedTransport creates the transport used when WithTunedTransport is provided
+func newTunedTransport(dnsCacheTTL time.Duration) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	cache := &dnsCache{
+		ttl:      dnsCacheTTL,
+		resolver: net.DefaultResolver,
+		dialer:   &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second},
+		entries:  make(map[string]dnsCacheEntry),
+	}
+	transport.DialContext = cache.DialContext
+	transport.TLSClientConfig = &tls.Config{ClientSessionCache: tls.NewLRUClientSessionCache(64)}
+	transport.MaxIdleConnsPerHost = 16
+	transport.IdleConnTimeout = 5 * time.Minute
+	return transport
+}
+
+type dnsCacheEntry struct {
+	addrs   []string
+	expires time.Time
+}
+
+// dnsCache dials connections using addresses resolved within its ttl
+type dnsCache struct {
+	ttl      time.Duration
+	resolver *net.Resolver
+	dialer   *net.Dialer
+	mu       sync.Mutex
+	entries  map[string]dnsCacheEntry
+}
+
+func (d *dnsCache) DialContext(ctx context.Context, network string, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil || net.ParseIP(host) != nil {
+		return d.dialer.DialContext(ctx, network, address)
+	}
+	addrs, err := d.lookup(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	var conn net.Conn
+	for _, addr := range addrs {
+		conn, err = d.dialer.DialContext(ctx, network, net.JoinHostPort(addr, port))
+		if err == nil {
+			return conn, nil
+		}
+	}
+	// the addresses may be stale, so they are resolved again on the next dial
+	d.mu.Lock()
+	delete(d.entries, host)
+	d.mu.Unlock()
+	return nil, err
+}
+
+func (d *dnsCache) lookup(ctx context.Context, host string) ([]string, error) {
+	d.mu.Lock()
+	entry, ok := d.entries[host]
+	d.mu.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		return entry.addrs, nil
+	}
+	addrs, err := d.resolver.LookupHost(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	d.mu.Lock()
+	d.entries[host] = dnsCacheEntry{addrs: addrs, expires: time.Now().Add(d.ttl)}
+	d.mu.Unlock()
+	return addrs, nil
+}
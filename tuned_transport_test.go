@@ -0,0 +1,28 @@
+package paystack
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDnsCache(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+
+	cache := &dnsCache{
+		ttl:      time.Minute,
+		resolver: net.DefaultResolver,
+		dialer:   &net.Dialer{},
+		entries:  map[string]dnsCacheEntry{"api.example.test": {addrs: []string{"127.0.0.1"}, expires: time.Now().Add(time.Minute)}},
+	}
+	conn, err := cache.DialContext(context.Background(), "tcp", net.JoinHostPort("api.example.test", port))
+	if err != nil {
+		t.Fatalf("expected the cached address to be dialed, got %v", err)
+	}
+	conn.Close()
+}
@@ -1,6 +1,7 @@
 package paystack
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 )
@@ -66,6 +67,78 @@ func (v *VerificationClient) ResolveAccount(queries ...Query) (*Response, error)
 	return v.APICall(http.MethodGet, url, nil)
 }
 
+// ErrAccountNameMismatch is returned by VerificationClient.EnsureNuban when the name of a resolved account
+// does not match the expected name.
+var ErrAccountNameMismatch = errors.New("resolved account name does not match the expected name")
+
+// NubanCheck is the result of VerificationClient.EnsureNuban
+type NubanCheck struct {
+	AccountNumber string
+	AccountName   string
+	// Score is the similarity score of the resolved account name and the expected name
+	Score float64
+}
+
+// EnsureNuban lets you confirm that a Nigerian bank account (NUBAN) exists and belongs to who you expect
+// before paying out to it. The account is resolved with ResolveAccount and the resolved account name is
+// compared with expectedName using matcher. If matcher is nil, the comparison is done with NameMatch.
+// ErrAccountNameMismatch is returned alongside the NubanCheck when the names do not match.
+//
+// Example:
+//
+//	import (
+//		"fmt"
+//		p "github.com/gray-adeyi/paystack"
+//	)
+//
+//	vClient := p.NewVerificationClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// Alternatively, you can access a Verification client from an APIClient
+//	// paystackClient := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	// paystackClient.Verification field is a `VerificationClient`
+//	// Therefore, this is possible
+//	// check, err := paystackClient.Verification.EnsureNuban("0022728151", "063", "Tolu Robert", nil)
+//
+//	// you can configure how strict the comparison should be with a `p.NameMatcher`
+//	// check, err := vClient.EnsureNuban("0022728151", "063", "Tolu Robert", &p.NameMatcher{Threshold: 0.9})
+//	check, err := vClient.EnsureNuban("0022728151", "063", "Tolu Robert", nil)
+//	if err != nil {
+//		panic(err)
+//	}
+//	fmt.Println(check.AccountName, check.Score)
+func (v *VerificationClient) EnsureNuban(accountNumber string, bankCode string, expectedName string, matcher *NameMatcher) (NubanCheck, error) {
+	check := NubanCheck{AccountNumber: accountNumber}
+	resp, err := v.ResolveAccount(WithQuery("account_number", accountNumber), WithQuery("bank_code", bankCode))
+	if err != nil {
+		return check, err
+	}
+	var account struct {
+		Status  bool   `json:"status"`
+		Message string `json:"message"`
+		Data    struct {
+			AccountNumber string `json:"account_number"`
+			AccountName   string `json:"account_name"`
+		} `json:"data"`
+	}
+	if err = resp.Decode(&account); err != nil {
+		return check, err
+	}
+	if !account.Status {
+		return check, fmt.Errorf("unable to resolve account %s: %s", accountNumber, account.Message)
+	}
+
+	check.AccountName = account.Data.AccountName
+	var ok bool
+	if matcher != nil {
+		check.Score, ok = matcher.Match(expectedName, check.AccountName)
+	} else {
+		check.Score, ok = NameMatch(expectedName, check.AccountName)
+	}
+	if !ok {
+		return check, ErrAccountNameMismatch
+	}
+	return check, nil
+}
+
 // ValidateAccount lets you confirm the authenticity of a customer's account number before sending money
 //
 // Example:
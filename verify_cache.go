@@ -0,0 +1,137 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// VerifyCache is implemented by types that cache the responses of TransactionClient.Verify. It is
+// registered with WithVerifyCache.
+type VerifyCache interface {
+	// Get should return false if a response has not been cached for the reference or it has expired
+	Get(reference string) (*Response, bool)
+	Set(reference string, response *Response, ttl time.Duration)
+}
+
+// minVerifyCacheSweep is the number of entries a MemoryVerifyCache holds before its first sweep
+const minVerifyCacheSweep = 64
+
+// MemoryVerifyCache is a VerifyCache that keeps responses in memory. Every caller gets its own copy of a
+// cached response, so modifying it does not affect the others. Expired responses are swept as the cache
+// grows, so it holds at most about twice as many responses as are live.
+type MemoryVerifyCache struct {
+	mu      sync.Mutex
+	entries map[string]verifyCacheEntry
+	// sweepAt is the number of entries at which expired entries are swept
+	sweepAt int
+}
+
+type verifyCacheEntry struct {
+	response *Response
+	expires  time.Time
+}
+
+// NewMemoryVerifyCache creates a MemoryVerifyCache
+func NewMemoryVerifyCache() *MemoryVerifyCache {
+	return &MemoryVerifyCache{entries: make(map[string]verifyCacheEntry), sweepAt: minVerifyCacheSweep}
+}
+
+func (m *MemoryVerifyCache) Get(reference string) (*Response, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.entries[reference]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(m.entries, reference)
+		return nil, false
+	}
+	return entry.response.clone(), true
+}
+
+func (m *MemoryVerifyCache) Set(reference string, response *Response, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	m.entries[reference] = verifyCacheEntry{response: response.clone(), expires: now.Add(ttl)}
+	if len(m.entries) < m.sweepAt {
+		return
+	}
+	for key, entry := range m.entries {
+		if now.After(entry.expires) {
+			delete(m.entries, key)
+		}
+	}
+	m.sweepAt = 2 * len(m.entries)
+	if m.sweepAt < minVerifyCacheSweep {
+		m.sweepAt = minVerifyCacheSweep
+	}
+}
+
+// WithVerifyCache lets you create an APIClient whose TransactionClient.Verify serves repeated
+// verifications of a reference within ttl from store. This shields both paystack and your application
+// from callback storms, e.g. a customer double submitting the callback of a payment. Only the responses
+// of transactions with a final status i.e. success, failed, abandoned or reversed are cached. Use TransactionClient.VerifyUncached to bypass the cache.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"),
+//		p.WithVerifyCache(p.NewMemoryVerifyCache(), 10*time.Second))
+func WithVerifyCache(store VerifyCache, ttl time.Duration) ClientOptions {
+	return func(client *APIClient) {
+		client.verifyCache = store
+		client.verifyCacheTTL = ttl
+	}
+}
+
+// VerifyUncached lets you verify a transaction without the cache registered with WithVerifyCache. The
+// response still replaces the cached response of the reference.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"),
+//		p.WithVerifyCache(p.NewMemoryVerifyCache(), 10*time.Second))
+//	resp, err := client.Transactions.VerifyUncached("<reference>")
+func (t *TransactionClient) VerifyUncached(reference string) (*Response, error) {
+	return t.verify(context.Background(), reference, false)
+}
+
+// verify verifies the transaction with reference, serving it from the cache registered with
+// WithVerifyCache if cached is true
+func (t *TransactionClient) verify(ctx context.Context, reference string, cached bool) (*Response, error) {
+	if cached && t.verifyCache != nil {
+		if response, ok := t.verifyCache.Get(reference); ok {
+			return response, nil
+		}
+	}
+	response, err := t.apiCall(ctx, http.MethodGet, fmt.Sprintf("/transaction/verify/%s", reference), nil)
+	if err == nil && t.verifyCache != nil && response.StatusCode == http.StatusOK {
+		var transaction struct {
+			Data struct {
+				Status string `json:"status"`
+			} `json:"data"`
+		}
+		// a transaction that is still e.g. ongoing or pending must be verified again to see its outcome
+		if response.Decode(&transaction) == nil && isFinalTransactionStatus(transaction.Data.Status) {
+			t.verifyCache.Set(reference, response, t.verifyCacheTTL)
+		}
+	}
+	return response, err
+}
+
+// isFinalTransactionStatus checks if the status of a transaction or charge can no longer change
+func isFinalTransactionStatus(status string) bool {
+	switch status {
+	case "success", "failed", "abandoned", "reversed":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package paystack
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithVerifyCache(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Write([]byte(`{"status":true,"message":"Verification successful","data":{"status":"success"}}`))
+	}))
+	defer server.Close()
+	client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL),
+		WithVerifyCache(NewMemoryVerifyCache(), time.Minute))
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.Transactions.Verify("ref"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Fatalf("expected repeated verifications to be served from the cache, got %d requests", got)
+	}
+	if _, err := client.Transactions.VerifyUncached("ref"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Transactions.Verify("other-ref"); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 3 {
+		t.Fatalf("expected 3 requests, got %d", got)
+	}
+}
+
+func TestWithVerifyCacheOnlyCachesFinalStatuses(t *testing.T) {
+	for _, tt := range []struct {
+		status string
+		cached bool
+	}{
+		{"success", true},
+		{"failed", true},
+		{"abandoned", true},
+		{"reversed", true},
+		{"ongoing", false},
+		{"pending", false},
+		{"processing", false},
+		{"queued", false},
+	} {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.Write([]byte(`{"status":true,"message":"Verification successful","data":{"status":"` + tt.status + `"}}`))
+		}))
+		client := NewAPIClient(WithSecretKey("sk_test"), WithBaseUrl(server.URL),
+			WithVerifyCache(NewMemoryVerifyCache(), time.Minute))
+		for i := 0; i < 2; i++ {
+			if _, err := client.Transactions.Verify("ref"); err != nil {
+				t.Fatal(err)
+			}
+		}
+		server.Close()
+		want := int32(2)
+		if tt.cached {
+			want = 1
+		}
+		if got := atomic.LoadInt32(&requests); got != want {
+			t.Errorf("%s: expected %d requests, got %d", tt.status, want, got)
+		}
+	}
+}
+
+func TestMemoryVerifyCacheExpires(t *testing.T) {
+	cache := NewMemoryVerifyCache()
+	cache.Set("ref", &Response{StatusCode: http.StatusOK}, -time.Second)
+	if _, ok := cache.Get("ref"); ok {
+		t.Fatal("expected an expired response to be a miss")
+	}
+}
+
+func TestMemoryVerifyCacheCopiesResponses(t *testing.T) {
+	cache := NewMemoryVerifyCache()
+	response := &Response{StatusCode: http.StatusOK, Data: []byte(`{"status":true}`), Headers: http.Header{"X-Id": {"1"}}}
+	cache.Set("ref", response, time.Minute)
+	response.Data[0] = 'x'
+
+	cached, _ := cache.Get("ref")
+	cached.Data[1] = 'x'
+	cached.Headers.Set("X-Id", "2")
+
+	cached, _ = cache.Get("ref")
+	if string(cached.Data) != `{"status":true}` || cached.Headers.Get("X-Id") != "1" {
+		t.Fatalf("expected the cached response to be unaffected by modifications, got %s %v", cached.Data, cached.Headers)
+	}
+}
+
+func TestMemoryVerifyCacheSweepsExpiredResponses(t *testing.T) {
+	cache := NewMemoryVerifyCache()
+	for i := 0; i < 1000; i++ {
+		cache.Set(strconv.Itoa(i), &Response{StatusCode: http.StatusOK}, -time.Second)
+	}
+	cache.Set("live", &Response{StatusCode: http.StatusOK}, time.Minute)
+	if len(cache.entries) > minVerifyCacheSweep {
+		t.Fatalf("expected expired responses to be swept, got %d entries", len(cache.entries))
+	}
+	if _, ok := cache.Get("live"); !ok {
+		t.Fatal("expected the live response to be kept")
+	}
+}
@@ -0,0 +1,60 @@
+package paystack
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// SDKInfo describes the build of the SDK a program runs. It is returned by VersionInfo.
+type SDKInfo struct {
+	// Version is the version of the SDK i.e. Version
+	Version string
+	// GoVersion is the version of Go the program was built with e.g. `go1.22.1`
+	GoVersion string
+	OS        string
+	Arch      string
+}
+
+// String returns the SDKInfo in the structured form sent in the User-Agent header of an APIClient created
+// with WithVersionTelemetry e.g. `sdk=0.1.0; go=go1.22.1; os=linux; arch=amd64`
+func (i SDKInfo) String() string {
+	return fmt.Sprintf("sdk=%s; go=%s; os=%s; arch=%s", i.Version, i.GoVersion, i.OS, i.Arch)
+}
+
+// VersionInfo lets you retrieve the version of the SDK and of Go your program runs, e.g. to report them in
+// the health endpoint of your service so that outdated versions of the SDK can be inventoried.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	info := p.VersionInfo()
+//	log.Printf("paystack sdk %s built with %s", info.Version, info.GoVersion)
+func VersionInfo() SDKInfo {
+	return SDKInfo{Version: Version, GoVersion: runtime.Version(), OS: runtime.GOOS, Arch: runtime.GOARCH}
+}
+
+// WithVersionTelemetry lets you opt in to sending the SDKInfo of your program to paystack in the User-Agent
+// header of every request of an APIClient e.g.
+// `github.com/gray-adeyi/paystack version 0.1.0 (sdk=0.1.0; go=go1.22.1; os=linux; arch=amd64)`, so that the
+// services running outdated versions of the SDK can be identified from paystack's request logs. Only the
+// version of the SDK is sent by default.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"), p.WithVersionTelemetry())
+func WithVersionTelemetry() ClientOptions {
+	return func(client *APIClient) {
+		client.versionTelemetry = true
+	}
+}
+
+// userAgentHeader returns the User-Agent header of the requests of the client
+func (a *baseAPIClient) userAgentHeader() string {
+	if !a.versionTelemetry {
+		return userAgent
+	}
+	return userAgent + " (" + VersionInfo().String() + ")"
+}
@@ -0,0 +1,35 @@
+package paystack
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+)
+
+func TestVersionTelemetry(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+		w.Write([]byte(`{"status":true,"message":"ok"}`))
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(WithSecretKey("sk_test_key"), WithBaseUrl(server.URL))
+	if _, err := client.APICall(http.MethodGet, "/balance", nil); err != nil {
+		t.Fatal(err)
+	}
+	if got != userAgent {
+		t.Errorf("got User-Agent %q without telemetry, want %q", got, userAgent)
+	}
+
+	client = NewAPIClient(WithSecretKey("sk_test_key"), WithBaseUrl(server.URL), WithVersionTelemetry())
+	if _, err := client.APICall(http.MethodGet, "/balance", nil); err != nil {
+		t.Fatal(err)
+	}
+	want := userAgent + " (sdk=" + Version + "; go=" + runtime.Version() + "; os=" + runtime.GOOS + "; arch=" +
+		runtime.GOARCH + ")"
+	if got != want {
+		t.Errorf("got User-Agent %q with telemetry, want %q", got, want)
+	}
+}
@@ -0,0 +1,267 @@
+package paystack
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrInvalidWebhookSignature is returned when the `x-paystack-signature` of a webhook request does not match
+// the signature computed from its body.
+var ErrInvalidWebhookSignature = errors.New("invalid paystack webhook signature")
+
+// maxWebhookBodySize is the maximum size of a webhook request body the WebhookHandler reads
+const maxWebhookBodySize = 1 << 20
+
+// webhookFailureRetention is how long a WebhookHandler remembers the failures of a resource after its
+// latest failure. paystack stops retrying the delivery of an event after 72 hours.
+var webhookFailureRetention = 72 * time.Hour
+
+// WebhookEvent is an event sent by paystack to your webhook url. WebhookEvent.Data is left as raw JSON
+// so that you're free to deserialize it as you wish.
+type WebhookEvent struct {
+	Event string          `json:"event"`
+	Data  json.RawMessage `json:"data"`
+}
+
+// ResourceId returns the id of the resource an event is about e.g. the transaction of a `charge.success`
+// event. An empty string is returned if the id could not be determined.
+func (e WebhookEvent) ResourceId() string {
+	var data map[string]interface{}
+	if err := json.Unmarshal(e.Data, &data); err != nil {
+		return ""
+	}
+	for _, key := range []string{"id", "reference", "code"} {
+		switch value := data[key].(type) {
+		case string:
+			if value != "" {
+				return value
+			}
+		case float64:
+			return fmt.Sprintf("%.0f", value)
+		}
+	}
+	return ""
+}
+
+// WebhookHandlerFunc is a function that processes a WebhookEvent. Returning an error signals paystack to
+// retry the delivery of the event.
+type WebhookHandlerFunc = func(event WebhookEvent) error
+
+// WebhookAlert contains the details of an event that consecutively failed to be processed. It is passed
+// to the alert callback registered with WithWebhookAlert.
+type WebhookAlert struct {
+	Event               string
+	ResourceId          string
+	ConsecutiveFailures int
+	LastError           error
+}
+
+// WebhookOptions is a type used to modify attributes of a WebhookHandler. It can be passed into the
+// NewWebhookHandler function while creating a WebhookHandler
+type WebhookOptions = func(handler *WebhookHandler)
+
+// WithWebhookAlert lets you register a callback that is invoked when processing an event for the same
+// resource fails threshold consecutive times. Since paystack retries failed deliveries on a schedule,
+// this gives you a chance to be notified before paystack gives up on the event.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	handler := p.NewWebhookHandler("<paystack-secret-key>", p.WithWebhookAlert(3, func(alert p.WebhookAlert) {
+//		log.Printf("%s for %s failed %d times: %v", alert.Event, alert.ResourceId,
+//			alert.ConsecutiveFailures, alert.LastError)
+//	}))
+func WithWebhookAlert(threshold int, callback func(alert WebhookAlert)) WebhookOptions {
+	return func(handler *WebhookHandler) {
+		handler.alertThreshold = threshold
+		handler.alertCallback = callback
+	}
+}
+
+// WebhookHandler is an http.Handler that verifies and dispatches the events paystack sends to your
+// webhook url. It should not be instantiated directly but via the NewWebhookHandler function.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	handler := p.NewWebhookHandler("<paystack-secret-key>")
+//	handler.On("charge.success", func(event p.WebhookEvent) error {
+//		// process the successful charge
+//		return nil
+//	})
+//	http.Handle("/webhook", handler)
+type WebhookHandler struct {
+	secretKey      string
+	mu             sync.Mutex
+	handlers       map[string]WebhookHandlerFunc
+	alertThreshold int
+	alertCallback  func(alert WebhookAlert)
+	failures       map[string]webhookFailures
+	// sweepFailuresAt is the number of failing resources at which failures are swept
+	sweepFailuresAt int
+	driftCallback   func(drift WebhookFieldDrift)
+	models          map[string]interface{}
+	reportedDrift   map[string]bool
+	middlewares     []WebhookMiddleware
+	validateSchema  bool
+	panicPolicy     PanicPolicy
+}
+
+// NewWebhookHandler lets you create a WebhookHandler. The secretKey is used to verify that events
+// were sent by paystack.
+func NewWebhookHandler(secretKey string, options ...WebhookOptions) *WebhookHandler {
+	handler := &WebhookHandler{
+		secretKey: secretKey,
+		handlers:  make(map[string]WebhookHandlerFunc),
+		failures:  make(map[string]webhookFailures),
+	}
+	for _, opts := range options {
+		opts(handler)
+	}
+	return handler
+}
+
+// On lets you register the function that processes events of the provided type e.g. `charge.success`.
+// event may also be a pattern ending with `*` e.g. `charge.*` or `transfer.*`, which matches the events
+// that start with the pattern and have no function registered for them, or `*` to match every event.
+// Registering a function for an event that already has one replaces it.
+func (h *WebhookHandler) On(event string, handlerFunc WebhookHandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.handlers[event] = handlerFunc
+}
+
+// ServeHTTP lets the WebhookHandler be used as an http.Handler. It responds with a 401 status code
+// when the signature of the request is invalid and a 500 status code when the event could not be
+// processed so that paystack retries the delivery.
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBodySize))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	err = h.Process(payload, r.Header.Get("x-paystack-signature"))
+	switch {
+	case errors.Is(err, ErrInvalidWebhookSignature):
+		w.WriteHeader(http.StatusUnauthorized)
+	case err != nil:
+		w.WriteHeader(http.StatusInternalServerError)
+	default:
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// Process lets you verify and dispatch an event when you're not using the WebhookHandler as an
+// http.Handler. payload is the body of the webhook request and signature is the value of its
+// `x-paystack-signature` header. A panic while processing the event is returned as a *PanicError unless
+// the PanicPolicy of the WebhookHandler is PanicCrash.
+func (h *WebhookHandler) Process(payload []byte, signature string) error {
+	if !h.verifySignature(payload, signature) {
+		return ErrInvalidWebhookSignature
+	}
+	var event WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return err
+	}
+	h.detectFieldDrift(event)
+	if h.validateSchema {
+		if err := ValidateWebhookSchema(event); err != nil {
+			return err
+		}
+	}
+
+	handlerFunc, ok := h.handlerFor(event.Event)
+	if !ok {
+		return nil
+	}
+	err := callSafely("webhook handler for "+event.Event, h.panicPolicy, func() error {
+		return handlerFunc(event)
+	})
+	h.recordResult(event, err)
+	return err
+}
+
+// webhookFailures are the consecutive failures of the events of a resource
+type webhookFailures struct {
+	count  int
+	lastAt time.Time
+}
+
+// FailingResources returns the number of resources whose latest event failed to be processed within the
+// last 72 hours, i.e. while paystack is still retrying its delivery
+func (h *WebhookHandler) FailingResources() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.sweepFailures(time.Now())
+	return len(h.failures)
+}
+
+// sweepFailures forgets the failures of resources that last failed before the retention period. h.mu must
+// be held.
+func (h *WebhookHandler) sweepFailures(now time.Time) {
+	for key, failures := range h.failures {
+		if now.Sub(failures.lastAt) > webhookFailureRetention {
+			delete(h.failures, key)
+		}
+	}
+}
+
+func (h *WebhookHandler) verifySignature(payload []byte, signature string) bool {
+	mac := hmac.New(sha512.New, []byte(h.secretKey))
+	mac.Write(payload)
+	expected := hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+func (h *WebhookHandler) recordResult(event WebhookEvent, err error) {
+	resourceId := event.ResourceId()
+	key := event.Event + ":" + resourceId
+
+	h.mu.Lock()
+	if err == nil {
+		delete(h.failures, key)
+		h.mu.Unlock()
+		return
+	}
+	now := time.Now()
+	// the failures are swept as they grow, so resources that never recover are not remembered forever
+	if len(h.failures) >= h.sweepFailuresAt {
+		h.sweepFailures(now)
+		h.sweepFailuresAt = 2*len(h.failures) + 1
+	}
+	previous := h.failures[key]
+	if now.Sub(previous.lastAt) > webhookFailureRetention {
+		previous.count = 0
+	}
+	failures := previous.count + 1
+	h.failures[key] = webhookFailures{count: failures, lastAt: now}
+	h.mu.Unlock()
+
+	if h.alertCallback != nil && h.alertThreshold > 0 && failures == h.alertThreshold {
+		alert := WebhookAlert{
+			Event:               event.Event,
+			ResourceId:          resourceId,
+			ConsecutiveFailures: failures,
+			LastError:           err,
+		}
+		// the event already failed, a panicking alert callback has nothing to add to its error
+		_ = callSafely("webhook alert callback", h.panicPolicy, func() error {
+			h.alertCallback(alert)
+			return nil
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package paystack
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// WebhookFieldDrift contains the fields of an event that are not defined on the model the event is
+// deserialized into. It is passed to the callback registered with WithWebhookFieldDrift.
+type WebhookFieldDrift struct {
+	Event  string
+	Fields []string
+}
+
+// defaultWebhookModels are the models the `data` of the events the SDK deserializes are compared against
+var defaultWebhookModels = map[string]interface{}{
+	WebhookEventSettlementSuccess: SettlementEvent{},
+	WebhookEventSettlementFailed:  SettlementEvent{},
+}
+
+// WithWebhookFieldDrift lets you register a callback that is invoked when the `data` of an event contains
+// fields that are not defined on the model of the event, e.g. SettlementEvent for `settlement.success`.
+// This helps you notice new fields added by paystack early. Each unknown field of an event is reported
+// only once. Models for other events can be registered with WithWebhookModel.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	handler := p.NewWebhookHandler("<paystack-secret-key>", p.WithWebhookFieldDrift(func(drift p.WebhookFieldDrift) {
+//		log.Printf("%s has new fields: %v", drift.Event, drift.Fields)
+//	}))
+func WithWebhookFieldDrift(callback func(drift WebhookFieldDrift)) WebhookOptions {
+	return func(handler *WebhookHandler) {
+		handler.driftCallback = callback
+	}
+}
+
+// WithWebhookModel lets you register the model the `data` of an event is compared against by the callback
+// registered with WithWebhookFieldDrift. model should be a struct whose fields have `json` tags.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	type Charge struct {
+//		Id        int    `json:"id"`
+//		Reference string `json:"reference"`
+//	}
+//
+//	handler := p.NewWebhookHandler("<paystack-secret-key>",
+//		p.WithWebhookModel("charge.success", Charge{}),
+//		p.WithWebhookFieldDrift(func(drift p.WebhookFieldDrift) {
+//			log.Printf("%s has new fields: %v", drift.Event, drift.Fields)
+//		}))
+func WithWebhookModel(event string, model interface{}) WebhookOptions {
+	return func(handler *WebhookHandler) {
+		if handler.models == nil {
+			handler.models = make(map[string]interface{})
+		}
+		handler.models[event] = model
+	}
+}
+
+// detectFieldDrift reports the fields of event that are not defined on its model and have not been
+// reported before.
+func (h *WebhookHandler) detectFieldDrift(event WebhookEvent) {
+	if h.driftCallback == nil {
+		return
+	}
+	model, ok := h.models[event.Event]
+	if !ok {
+		model, ok = defaultWebhookModels[event.Event]
+	}
+	if !ok {
+		return
+	}
+	var data map[string]json.RawMessage
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		return
+	}
+	known := modelFields(reflect.TypeOf(model))
+
+	var fields []string
+	h.mu.Lock()
+	if h.reportedDrift == nil {
+		h.reportedDrift = make(map[string]bool)
+	}
+	for field := range data {
+		key := event.Event + ":" + field
+		if known[field] || h.reportedDrift[key] {
+			continue
+		}
+		h.reportedDrift[key] = true
+		fields = append(fields, field)
+	}
+	h.mu.Unlock()
+
+	if len(fields) > 0 {
+		sort.Strings(fields)
+		// drift is informational, so a panicking drift callback must not fail the event
+		_ = callSafely("webhook drift callback", h.panicPolicy, func() error {
+			h.driftCallback(WebhookFieldDrift{Event: event.Event, Fields: fields})
+			return nil
+		})
+	}
+}
+
+// modelFields returns the names of the json fields of a struct
+func modelFields(t reflect.Type) map[string]bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	fields := make(map[string]bool)
+	if t.Kind() != reflect.Struct {
+		return fields
+	}
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(structField.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = structField.Name
+		}
+		fields[name] = true
+	}
+	return fields
+}
@@ -0,0 +1,111 @@
+package paystack
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// WebhookEventSettlementSuccess is the event sent by paystack when a settlement is paid out to your bank account
+const WebhookEventSettlementSuccess = "settlement.success"
+
+// WebhookEventSettlementFailed is the event sent by paystack when a settlement could not be paid out
+const WebhookEventSettlementFailed = "settlement.failed"
+
+// SettlementEvent is the deserialized form of a `settlement.success` or `settlement.failed` WebhookEvent
+type SettlementEvent struct {
+	Event           string      `json:"-"`
+	Id              json.Number `json:"id"`
+	Domain          string      `json:"domain"`
+	Status          string      `json:"status"`
+	Currency        string      `json:"currency"`
+	TotalAmount     int         `json:"total_amount"`
+	EffectiveAmount int         `json:"effective_amount"`
+	TotalFees       int         `json:"total_fees"`
+	SettledBy       string      `json:"settled_by"`
+	SettlementDate  string      `json:"settlement_date"`
+	CreatedAt       string      `json:"createdAt"`
+	UpdatedAt       string      `json:"updatedAt"`
+
+	// Data is the raw data of the event
+	Data json.RawMessage `json:"-"`
+
+	// Transactions is the response of retrieving the transactions of the settlement. It is only populated
+	// by WebhookHandler.OnSettlement when a SettlementClient is provided.
+	Transactions *Response `json:"-"`
+}
+
+// DecodeSettlementEvent lets you deserialize a `settlement.success` or `settlement.failed` WebhookEvent
+func DecodeSettlementEvent(event WebhookEvent) (SettlementEvent, error) {
+	var settlement SettlementEvent
+	if err := json.Unmarshal(event.Data, &settlement); err != nil {
+		return settlement, err
+	}
+	if settlement.SettlementDate == "" {
+		// the settlement date is not consistently named across paystack's payloads
+		var alternatives struct {
+			SettlementDate string `json:"settlementDate"`
+			SettlmentDate  string `json:"settlment_date"`
+		}
+		if err := json.Unmarshal(event.Data, &alternatives); err == nil {
+			settlement.SettlementDate = alternatives.SettlementDate
+			if settlement.SettlementDate == "" {
+				settlement.SettlementDate = alternatives.SettlmentDate
+			}
+		}
+	}
+	settlement.Event = event.Event
+	settlement.Data = event.Data
+	return settlement, nil
+}
+
+// SettledAt returns the SettlementDate of the SettlementEvent normalized to UTC
+func (s SettlementEvent) SettledAt() (time.Time, error) {
+	return ParseTime(s.SettlementDate)
+}
+
+// SettledIn returns the SettlementDate of the SettlementEvent in loc, e.g. the timezone of your finance team
+//
+// Example
+//
+//	lagos, _ := time.LoadLocation("Africa/Lagos")
+//	settledAt, err := settlement.SettledIn(lagos)
+func (s SettlementEvent) SettledIn(loc *time.Location) (time.Time, error) {
+	settledAt, err := s.SettledAt()
+	if err != nil {
+		return settledAt, err
+	}
+	return settledAt.In(loc), nil
+}
+
+// OnSettlement lets you register a function that processes `settlement.success` and `settlement.failed`
+// events. If client is not nil, the transactions of the settlement are retrieved with
+// SettlementClient.AllTransactions before handlerFunc is called and made available as
+// SettlementEvent.Transactions.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	client := p.NewAPIClient(p.WithSecretKey("<paystack-secret-key>"))
+//	handler := p.NewWebhookHandler("<paystack-secret-key>")
+//	handler.OnSettlement(client.Settlements, func(settlement p.SettlementEvent) error {
+//		// notify the finance team
+//		return nil
+//	})
+func (h *WebhookHandler) OnSettlement(client *SettlementClient, handlerFunc func(settlement SettlementEvent) error) {
+	process := func(event WebhookEvent) error {
+		settlement, err := DecodeSettlementEvent(event)
+		if err != nil {
+			return err
+		}
+		if client != nil {
+			settlement.Transactions, err = client.AllTransactions(settlement.Id.String())
+			if err != nil {
+				return err
+			}
+		}
+		return handlerFunc(settlement)
+	}
+	h.On(WebhookEventSettlementSuccess, process)
+	h.On(WebhookEventSettlementFailed, process)
+}
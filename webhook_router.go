@@ -0,0 +1,145 @@
+package paystack
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WebhookMiddleware wraps the WebhookHandlerFunc that processes an event. A WebhookMiddleware can act before
+// and after the event is processed e.g. for logging, metrics, panic recovery or skipping duplicate events.
+type WebhookMiddleware func(next WebhookHandlerFunc) WebhookHandlerFunc
+
+// Use lets you add middlewares that every event dispatched to a registered function passes through. The
+// first middleware is the outermost, i.e. it sees an event first.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	handler := p.NewWebhookHandler("<paystack-secret-key>")
+//	handler.Use(p.WebhookRecoverer(), p.WebhookLogger(log.Default()), p.WebhookDeduplicator(24*time.Hour))
+func (h *WebhookHandler) Use(middlewares ...WebhookMiddleware) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.middlewares = append(h.middlewares, middlewares...)
+}
+
+// handlerFor returns the function registered for event wrapped with the middlewares of the handler. A
+// function registered for the exact event is preferred, then the function of the longest matching pattern
+// e.g. `charge.dispute.*` over `charge.*`, and lastly the function registered for `*`.
+func (h *WebhookHandler) handlerFor(event string) (WebhookHandlerFunc, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	handlerFunc, ok := h.handlers[event]
+	if !ok {
+		longest := -1
+		for pattern, patternHandlerFunc := range h.handlers {
+			prefix, isPattern := strings.CutSuffix(pattern, "*")
+			if isPattern && strings.HasPrefix(event, prefix) && len(prefix) > longest {
+				handlerFunc, ok, longest = patternHandlerFunc, true, len(prefix)
+			}
+		}
+	}
+	if !ok {
+		return nil, false
+	}
+	for i := len(h.middlewares) - 1; i >= 0; i-- {
+		handlerFunc = h.middlewares[i](handlerFunc)
+	}
+	return handlerFunc, true
+}
+
+// WebhookRecoverer is a WebhookMiddleware that recovers from panics while processing an event, returning
+// them as a *PanicError so that paystack retries the delivery of the event instead of the panic crashing
+// your server. It recovers panics regardless of the PanicPolicy of the WebhookHandler, so that the
+// middlewares registered before it see the error.
+func WebhookRecoverer() WebhookMiddleware {
+	return func(next WebhookHandlerFunc) WebhookHandlerFunc {
+		return func(event WebhookEvent) error {
+			return callSafely("webhook handler for "+event.Event, PanicRecover, func() error {
+				return next(event)
+			})
+		}
+	}
+}
+
+// WebhookLogger is a WebhookMiddleware that logs the outcome and duration of processing every event with
+// logger
+func WebhookLogger(logger *log.Logger) WebhookMiddleware {
+	return func(next WebhookHandlerFunc) WebhookHandlerFunc {
+		return func(event WebhookEvent) error {
+			start := time.Now()
+			err := next(event)
+			if err != nil {
+				logger.Printf("paystack webhook: %s %s failed after %s: %v", event.Event, event.ResourceId(), time.Since(start), err)
+			} else {
+				logger.Printf("paystack webhook: %s %s processed in %s", event.Event, event.ResourceId(), time.Since(start))
+			}
+			return err
+		}
+	}
+}
+
+// ErrWebhookEventInProgress is returned by the WebhookMiddleware of WebhookDeduplicator for a delivery of an
+// event that is still being processed from another delivery, so that paystack retries it later instead of
+// the event being processed twice at the same time.
+var ErrWebhookEventInProgress = errors.New("webhook event is already being processed")
+
+// WebhookDeduplicator is a WebhookMiddleware that skips events that were successfully processed within
+// ttl, since paystack may deliver an event more than once. Events are identified by their type and
+// WebhookEvent.ResourceId and are remembered in memory, so duplicates are only detected by the instance of
+// your application that processed the original event. A delivery of an event that is still being
+// processed fails with ErrWebhookEventInProgress.
+func WebhookDeduplicator(ttl time.Duration) WebhookMiddleware {
+	var mu sync.Mutex
+	// processed holds when events were processed, or the zero time for events being processed
+	processed := make(map[string]time.Time)
+	return func(next WebhookHandlerFunc) WebhookHandlerFunc {
+		return func(event WebhookEvent) error {
+			resourceId := event.ResourceId()
+			if resourceId == "" {
+				return next(event)
+			}
+			key := event.Event + ":" + resourceId
+			now := time.Now()
+			mu.Lock()
+			for k, processedAt := range processed {
+				if !processedAt.IsZero() && now.Sub(processedAt) > ttl {
+					delete(processed, k)
+				}
+			}
+			// the event is claimed under the same lock it is checked with, so concurrent deliveries of it
+			// can not both be processed
+			processedAt, seen := processed[key]
+			if !seen {
+				processed[key] = time.Time{}
+			}
+			mu.Unlock()
+			if seen && processedAt.IsZero() {
+				return ErrWebhookEventInProgress
+			}
+			if seen {
+				return nil
+			}
+			succeeded := false
+			// the claim is released even if next panics, so that the event can be delivered again
+			defer func() {
+				mu.Lock()
+				defer mu.Unlock()
+				if succeeded {
+					processed[key] = time.Now()
+				} else {
+					delete(processed, key)
+				}
+			}()
+			if err := next(event); err != nil {
+				return err
+			}
+			succeeded = true
+			return nil
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package paystack
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrInvalidWebhookPayload is wrapped by the error of an event whose payload does not match the schema of
+// its type, e.g. a delivery truncated or mangled by a proxy
+var ErrInvalidWebhookPayload = errors.New("invalid webhook payload")
+
+// WebhookSchemaError is returned by ValidateWebhookSchema for an event that does not match its schema
+type WebhookSchemaError struct {
+	Event string
+	// Violations describe where the payload does not match the schema e.g. `data.customer: missing required
+	// field "email"`
+	Violations []string
+}
+
+func (e *WebhookSchemaError) Error() string {
+	return fmt.Sprintf("%v: %s: %s", ErrInvalidWebhookPayload, e.Event, strings.Join(e.Violations, "; "))
+}
+
+func (e *WebhookSchemaError) Unwrap() error {
+	return ErrInvalidWebhookPayload
+}
+
+//go:embed schemas/webhooks/*.json
+var webhookSchemaFiles embed.FS
+
+// webhookSchemas maps events to the file of the schema of their `data`
+var webhookSchemas = map[string]string{
+	"charge.success":              "charge.json",
+	"transfer.success":            "transfer.json",
+	"transfer.failed":             "transfer.json",
+	"transfer.reversed":           "transfer.json",
+	"subscription.create":         "subscription.json",
+	"subscription.disable":        "subscription.json",
+	"subscription.not_renew":      "subscription.json",
+	"invoice.create":              "invoice.json",
+	"invoice.update":              "invoice.json",
+	"invoice.payment_failed":      "invoice.json",
+	"refund.pending":              "refund.json",
+	"refund.processing":           "refund.json",
+	"refund.processed":            "refund.json",
+	"refund.failed":               "refund.json",
+	"charge.dispute.create":       "dispute.json",
+	"charge.dispute.remind":       "dispute.json",
+	"charge.dispute.resolve":      "dispute.json",
+	WebhookEventSettlementSuccess: "settlement.json",
+	WebhookEventSettlementFailed:  "settlement.json",
+}
+
+// jsonSchema is the subset of JSON schema the vendored webhook schemas are written in
+type jsonSchema struct {
+	Type       jsonSchemaTypes        `json:"type"`
+	Required   []string               `json:"required"`
+	Properties map[string]*jsonSchema `json:"properties"`
+	Items      *jsonSchema            `json:"items"`
+	Enum       []interface{}          `json:"enum"`
+}
+
+// jsonSchemaTypes is the `type` of a jsonSchema, which may be a single type or a list of types
+type jsonSchemaTypes []string
+
+func (t *jsonSchemaTypes) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = jsonSchemaTypes{single}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(t))
+}
+
+// ValidateWebhookSchema lets you check that the `data` of an event has the structure paystack sends for its
+// type before processing it, e.g. that the `customer` of a `charge.success` event has an email. Schemas are
+// shipped for the charge, transfer, subscription, invoice, refund, dispute and settlement events, and only
+// the structure common to all events is checked for other events. Fields that are not in a schema are
+// allowed. An error wrapping ErrInvalidWebhookPayload is returned for an invalid event.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	handler.On("charge.success", func(event p.WebhookEvent) error {
+//		if err := p.ValidateWebhookSchema(event); err != nil {
+//			return err // paystack retries the delivery
+//		}
+//		// process the successful charge
+//		return nil
+//	})
+func ValidateWebhookSchema(event WebhookEvent) error {
+	schemaErr := &WebhookSchemaError{Event: event.Event}
+	if event.Event == "" {
+		schemaErr.Violations = append(schemaErr.Violations, `missing required field "event"`)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(event.Data))
+	decoder.UseNumber()
+	var data interface{}
+	if err := decoder.Decode(&data); err != nil {
+		schemaErr.Violations = append(schemaErr.Violations, fmt.Sprintf("data: %v", err))
+		return schemaErr
+	}
+	schema, err := webhookSchema(event.Event)
+	if err != nil {
+		return err
+	}
+	if schema == nil {
+		schema = &jsonSchema{Type: jsonSchemaTypes{"object"}}
+	}
+	schemaErr.Violations = append(schemaErr.Violations, schema.validate("data", data)...)
+	if len(schemaErr.Violations) > 0 {
+		return schemaErr
+	}
+	return nil
+}
+
+// WithWebhookSchemaValidation lets you create a WebhookHandler that validates events with ValidateWebhookSchema
+// before dispatching them. An invalid event is not dispatched and its error is returned, so paystack retries
+// its delivery.
+//
+// Example
+//
+//	import p "github.com/gray-adeyi/paystack"
+//
+//	handler := p.NewWebhookHandler("<paystack-secret-key>", p.WithWebhookSchemaValidation())
+func WithWebhookSchemaValidation() WebhookOptions {
+	return func(handler *WebhookHandler) {
+		handler.validateSchema = true
+	}
+}
+
+// webhookSchema returns the schema of the `data` of event, or nil if no schema is shipped for it
+func webhookSchema(event string) (*jsonSchema, error) {
+	file, ok := webhookSchemas[event]
+	if !ok {
+		return nil, nil
+	}
+	data, err := webhookSchemaFiles.ReadFile("schemas/webhooks/" + file)
+	if err != nil {
+		return nil, err
+	}
+	var schema jsonSchema
+	if err = json.Unmarshal(data, &schema); err != nil {
+		return nil, err
+	}
+	return &schema, nil
+}
+
+// validate returns the violations of the schema by value, whose location in the payload is path
+func (s *jsonSchema) validate(path string, value interface{}) []string {
+	if len(s.Type) > 0 && !s.matchesType(value) {
+		return []string{fmt.Sprintf("%s: expected %s, got %s", path, strings.Join(s.Type, " or "), jsonTypeOf(value))}
+	}
+	if len(s.Enum) > 0 && !s.inEnum(value) {
+		return []string{fmt.Sprintf("%s: %v is not one of %v", path, value, s.Enum)}
+	}
+	var violations []string
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for _, field := range s.Required {
+			if _, ok := v[field]; !ok {
+				violations = append(violations, fmt.Sprintf("%s: missing required field %q", path, field))
+			}
+		}
+		fields := make([]string, 0, len(s.Properties))
+		for field := range s.Properties {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			if child, ok := v[field]; ok {
+				violations = append(violations, s.Properties[field].validate(path+"."+field, child)...)
+			}
+		}
+	case []interface{}:
+		if s.Items != nil {
+			for i, item := range v {
+				violations = append(violations, s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item)...)
+			}
+		}
+	}
+	return violations
+}
+
+func (s *jsonSchema) matchesType(value interface{}) bool {
+	actual := jsonTypeOf(value)
+	for _, expected := range s.Type {
+		if expected == actual || (expected == "number" && actual == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *jsonSchema) inEnum(value interface{}) bool {
+	for _, allowed := range s.Enum {
+		if fmt.Sprint(allowed) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonTypeOf returns the JSON schema type of a value decoded with json.Decoder.UseNumber
+func jsonTypeOf(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
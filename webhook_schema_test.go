@@ -0,0 +1,62 @@
+package paystack
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateWebhookSchema(t *testing.T) {
+	for _, c := range []struct {
+		name      string
+		event     WebhookEvent
+		violation string
+	}{
+		{"valid", WebhookEvent{Event: "charge.success", Data: []byte(`{"id":302961,"status":"success",
+			"reference":"qTPrJoy9Bx","amount":10000,"currency":"NGN","customer":{"email":"jane@example.com"},
+			"new_field":true}`)}, ""},
+		{"missing nested field", WebhookEvent{Event: "charge.success", Data: []byte(`{"id":302961,"status":"success",
+			"reference":"qTPrJoy9Bx","amount":10000,"currency":"NGN","customer":{}}`)}, `data.customer: missing required field "email"`},
+		{"wrong type", WebhookEvent{Event: "transfer.success", Data: []byte(`{"amount":"10000","currency":"NGN",
+			"reference":"ref","status":"success","transfer_code":"TRF_1","recipient":{"recipient_code":"RCP_1"}}`)},
+			"data.amount: expected integer, got string"},
+		{"truncated", WebhookEvent{Event: "charge.success", Data: []byte(`{"id":302961,"status":"succ`)}, "data:"},
+		{"unknown event", WebhookEvent{Event: "paymentrequest.success", Data: []byte(`{"id":1}`)}, ""},
+		{"not an object", WebhookEvent{Event: "paymentrequest.success", Data: []byte(`[]`)}, "data: expected object"},
+	} {
+		err := ValidateWebhookSchema(c.event)
+		if c.violation == "" {
+			if err != nil {
+				t.Errorf("%s: expected no error, got %v", c.name, err)
+			}
+			continue
+		}
+		if !errors.Is(err, ErrInvalidWebhookPayload) || !strings.Contains(err.Error(), c.violation) {
+			t.Errorf("%s: expected a violation %q, got %v", c.name, c.violation, err)
+		}
+	}
+}
+
+func TestWebhookSchemasAreValid(t *testing.T) {
+	for event := range webhookSchemas {
+		if schema, err := webhookSchema(event); err != nil || schema == nil {
+			t.Errorf("%s: expected a schema, got %v", event, err)
+		}
+	}
+}
+
+func TestWebhookHandlerValidatesSchema(t *testing.T) {
+	var dispatched bool
+	handler := NewWebhookHandler("sk_test", WithWebhookSchemaValidation())
+	handler.On("charge.success", func(event WebhookEvent) error {
+		dispatched = true
+		return nil
+	})
+	payload := []byte(`{"event":"charge.success","data":{"id":302961}}`)
+	if err := handler.Process(payload, signWebhookPayload("sk_test", payload)); !errors.Is(err, ErrInvalidWebhookPayload) {
+		t.Fatalf("expected %v, got %v", ErrInvalidWebhookPayload, err)
+	}
+	if dispatched {
+		t.Fatal("expected the invalid event not to be dispatched")
+	}
+}
@@ -0,0 +1,180 @@
+package paystack
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func signWebhookPayload(secretKey string, payload []byte) string {
+	mac := hmac.New(sha512.New, []byte(secretKey))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestWebhookHandlerRejectsInvalidSignature(t *testing.T) {
+	handler := NewWebhookHandler("sk_test")
+	payload := []byte(`{"event":"charge.success","data":{"id":1}}`)
+	if err := handler.Process(payload, signWebhookPayload("sk_other", payload)); !errors.Is(err, ErrInvalidWebhookSignature) {
+		t.Fatalf("expected %v, got %v", ErrInvalidWebhookSignature, err)
+	}
+}
+
+func TestWebhookHandlerAlertsOnConsecutiveFailures(t *testing.T) {
+	var alerts []WebhookAlert
+	handler := NewWebhookHandler("sk_test", WithWebhookAlert(2, func(alert WebhookAlert) {
+		alerts = append(alerts, alert)
+	}))
+	processingErr := errors.New("database unavailable")
+	handler.On("charge.success", func(event WebhookEvent) error {
+		return processingErr
+	})
+
+	payload := []byte(`{"event":"charge.success","data":{"id":302961,"reference":"ref_1"}}`)
+	for i := 0; i < 3; i++ {
+		if err := handler.Process(payload, signWebhookPayload("sk_test", payload)); !errors.Is(err, processingErr) {
+			t.Fatalf("expected %v, got %v", processingErr, err)
+		}
+	}
+	if len(alerts) != 1 {
+		t.Fatalf("expected 1 alert, got %d", len(alerts))
+	}
+	alert := alerts[0]
+	if alert.Event != "charge.success" || alert.ResourceId != "302961" || alert.ConsecutiveFailures != 2 {
+		t.Fatalf("unexpected alert %+v", alert)
+	}
+}
+
+func TestWebhookHandlerReportsFieldDrift(t *testing.T) {
+	var drifts []WebhookFieldDrift
+	handler := NewWebhookHandler("sk_test", WithWebhookFieldDrift(func(drift WebhookFieldDrift) {
+		drifts = append(drifts, drift)
+	}))
+
+	payload := []byte(`{"event":"settlement.success","data":{"id":1,"status":"success","payout_rail":"nip","batch":"b_1"}}`)
+	for i := 0; i < 2; i++ {
+		if err := handler.Process(payload, signWebhookPayload("sk_test", payload)); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	if len(drifts) != 1 {
+		t.Fatalf("expected 1 drift report, got %d", len(drifts))
+	}
+	if drifts[0].Event != "settlement.success" || len(drifts[0].Fields) != 2 ||
+		drifts[0].Fields[0] != "batch" || drifts[0].Fields[1] != "payout_rail" {
+		t.Fatalf("unexpected drift %+v", drifts[0])
+	}
+}
+
+func TestWebhookHandlerRoutesPatterns(t *testing.T) {
+	handler := NewWebhookHandler("sk_test")
+	var routed []string
+	route := func(name string) WebhookHandlerFunc {
+		return func(event WebhookEvent) error {
+			routed = append(routed, name)
+			return nil
+		}
+	}
+	handler.On("charge.success", route("charge.success"))
+	handler.On("charge.*", route("charge.*"))
+	handler.On("charge.dispute.*", route("charge.dispute.*"))
+	handler.On("*", route("*"))
+
+	for _, event := range []string{"charge.success", "charge.failed", "charge.dispute.create", "transfer.success"} {
+		payload := []byte(`{"event":"` + event + `","data":{}}`)
+		if err := handler.Process(payload, signWebhookPayload("sk_test", payload)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected := []string{"charge.success", "charge.*", "charge.dispute.*", "*"}
+	if !reflect.DeepEqual(routed, expected) {
+		t.Fatalf("expected events to be routed to %v, got %v", expected, routed)
+	}
+}
+
+func TestWebhookHandlerMiddlewares(t *testing.T) {
+	handler := NewWebhookHandler("sk_test")
+	var processed int
+	handler.Use(WebhookRecoverer(), WebhookDeduplicator(time.Hour))
+	handler.On("transfer.success", func(event WebhookEvent) error {
+		processed++
+		return nil
+	})
+	handler.On("transfer.failed", func(event WebhookEvent) error {
+		panic("unexpected transfer")
+	})
+
+	payload := []byte(`{"event":"transfer.success","data":{"id":1}}`)
+	for i := 0; i < 2; i++ {
+		if err := handler.Process(payload, signWebhookPayload("sk_test", payload)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if processed != 1 {
+		t.Fatalf("expected the duplicate event to be skipped, processed %d events", processed)
+	}
+	payload = []byte(`{"event":"transfer.failed","data":{"id":2}}`)
+	if err := handler.Process(payload, signWebhookPayload("sk_test", payload)); err == nil {
+		t.Fatal("expected the panic to be recovered as an error")
+	}
+}
+
+func TestWebhookDeduplicatorIsAtomic(t *testing.T) {
+	handler := NewWebhookHandler("sk_test")
+	handler.Use(WebhookDeduplicator(time.Hour))
+	started, release := make(chan struct{}), make(chan struct{})
+	var processed int32
+	handler.On("transfer.success", func(event WebhookEvent) error {
+		if atomic.AddInt32(&processed, 1) == 1 {
+			close(started)
+			<-release
+		}
+		return nil
+	})
+
+	payload := []byte(`{"event":"transfer.success","data":{"id":1}}`)
+	first := make(chan error)
+	go func() {
+		first <- handler.Process(payload, signWebhookPayload("sk_test", payload))
+	}()
+	<-started
+	if err := handler.Process(payload, signWebhookPayload("sk_test", payload)); !errors.Is(err, ErrWebhookEventInProgress) {
+		t.Fatalf("expected %v for a concurrent delivery, got %v", ErrWebhookEventInProgress, err)
+	}
+	close(release)
+	if err := <-first; err != nil {
+		t.Fatal(err)
+	}
+	if err := handler.Process(payload, signWebhookPayload("sk_test", payload)); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(&processed); got != 1 {
+		t.Fatalf("expected the event to be processed once, processed %d times", got)
+	}
+}
+
+func TestWebhookHandlerForgetsOldFailures(t *testing.T) {
+	defer func(retention time.Duration) { webhookFailureRetention = retention }(webhookFailureRetention)
+	webhookFailureRetention = time.Millisecond
+	handler := NewWebhookHandler("sk_test")
+	handler.On("transfer.failed", func(event WebhookEvent) error {
+		return errors.New("unable to process transfer")
+	})
+	for i := 0; i < 3; i++ {
+		payload := []byte(`{"event":"transfer.failed","data":{"id":` + strconv.Itoa(i) + `}}`)
+		handler.Process(payload, signWebhookPayload("sk_test", payload))
+	}
+	if got := handler.FailingResources(); got != 3 {
+		t.Fatalf("expected 3 failing resources, got %d", got)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if got := handler.FailingResources(); got != 0 {
+		t.Fatalf("expected the failures to be forgotten after the retention period, got %d", got)
+	}
+}